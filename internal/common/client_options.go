@@ -86,6 +86,16 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
 }
 
+// ConfigureWithoutLogging sets up the user agent and transport of a client, without the middleware which logs the
+// request and response bodies - for use where these contain credentials
+func (o ClientOptions) ConfigureWithoutLogging(c client.BaseClient) {
+	c.SetUserAgent(userAgent(c.GetUserAgent(), o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID))
+
+	if o.Transport != nil {
+		c.SetTransport(o.Transport)
+	}
+}
+
 // ConfigureClient sets up an autorest.Client using an autorest.Authorizer
 func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.Authorizer) {
 	c.UserAgent = userAgent(c.UserAgent, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
@@ -642,8 +642,18 @@ func (p *azureRmFrameworkProvider) EphemeralResources(_ context.Context) []func(
 
 func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
+		providerfunction.NewAllocateSubnetsFunction,
+		providerfunction.NewBuildResourceIDFunction,
+		providerfunction.NewEventHubSASFunction,
+		providerfunction.NewIsResourceIDOfTypeFunction,
 		providerfunction.NewNormaliseResourceIDFunction,
 		providerfunction.NewParseResourceIDFunction,
+		providerfunction.NewServiceBusSASFunction,
+		providerfunction.NewStorageAccountSASFunction,
+		providerfunction.NewStorageBlobSASFunction,
+		providerfunction.NewStorageContainerSASFunction,
+		providerfunction.NewStorageQueueSASFunction,
+		providerfunction.NewStorageTableSASFunction,
 	}
 }
 
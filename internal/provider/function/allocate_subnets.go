@@ -0,0 +1,307 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type AllocateSubnetsFunction struct{}
+
+var _ function.Function = AllocateSubnetsFunction{}
+
+const (
+	// azureReservedAddresses is the number of addresses Azure reserves within every subnet
+	azureReservedAddresses = 5
+
+	// smallestSubnetPrefixLength is the smallest subnet supported by Azure
+	smallestSubnetPrefixLength = 29
+)
+
+// subnetMaximumPrefixLengths contains the smallest subnet (i.e. the largest prefix length) supported for subnets which
+// must use a specific name
+var subnetMaximumPrefixLengths = map[string]int{
+	"AzureBastionSubnet":            26,
+	"AzureFirewallManagementSubnet": 26,
+	"AzureFirewallSubnet":           26,
+	"GatewaySubnet":                 27,
+	"RouteServerSubnet":             27,
+}
+
+// delegationMaximumPrefixLengths contains the smallest subnet (i.e. the largest prefix length) supported for subnets
+// delegated to a service
+var delegationMaximumPrefixLengths = map[string]int{
+	"Microsoft.App/environments":                27,
+	"Microsoft.Network/dnsResolvers":            28,
+	"Microsoft.Sql/managedInstances":            27,
+	"Microsoft.Web/hostingEnvironments":         24,
+	"Microsoft.Web/serverFarms":                 28,
+	"Microsoft.DBforPostgreSQL/flexibleServers": 28,
+}
+
+type subnetRequirement struct {
+	name         string
+	prefixLength int
+}
+
+func NewAllocateSubnetsFunction() function.Function {
+	return &AllocateSubnetsFunction{}
+}
+
+func (a AllocateSubnetsFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "allocate_subnets"
+}
+
+func (a AllocateSubnetsFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "allocate_subnets",
+		Description:         "Allocates non-overlapping subnets within the address space of a Virtual Network, taking into account the addresses reserved by Azure and the minimum sizes of special and delegated subnets",
+		MarkdownDescription: "Allocates non-overlapping subnets within the address space of a Virtual Network, taking into account the addresses reserved by Azure and the minimum sizes of special and delegated subnets",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "address_space",
+				Description:         "The IPv4 address space of the Virtual Network in CIDR notation",
+				MarkdownDescription: "The IPv4 address space of the Virtual Network in CIDR notation",
+				ElementType:         types.StringType,
+			},
+			function.DynamicParameter{
+				Name:                "subnets",
+				Description:         "A list of objects with a name and either a host_count or prefix_length, optionally with the delegation service name",
+				MarkdownDescription: "A list of objects with a `name` and either a `host_count` or `prefix_length`, optionally with the `delegation` service name",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (a AllocateSubnetsFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var addressSpace []string
+	var subnets types.Dynamic
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &addressSpace, &subnets))
+
+	if response.Error != nil {
+		return
+	}
+
+	spaces := make([]netip.Prefix, 0, len(addressSpace))
+	for _, v := range addressSpace {
+		prefix, err := netip.ParsePrefix(v)
+		if err != nil {
+			response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("parsing address space %q: %+v", v, err))
+			return
+		}
+		if !prefix.Addr().Is4() {
+			response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("address space %q must be an IPv4 CIDR", v))
+			return
+		}
+		prefix = prefix.Masked()
+
+		for i, existing := range spaces {
+			if prefix.Overlaps(existing) {
+				response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("address space %q overlaps with %q", v, addressSpace[i]))
+				return
+			}
+		}
+		spaces = append(spaces, prefix)
+	}
+
+	if len(spaces) == 0 {
+		response.Error = function.NewArgumentFuncError(0, "at least one address space must be specified")
+		return
+	}
+
+	requirements, err := expandSubnetRequirements(subnets)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	// a subnet can't be larger than the address space it's allocated within
+	largest := slices.MinFunc(spaces, func(a, b netip.Prefix) int {
+		return a.Bits() - b.Bits()
+	})
+	for _, r := range requirements {
+		if r.prefixLength < largest.Bits() {
+			response.Error = function.NewArgumentFuncError(1, fmt.Sprintf("the subnet %q (/%d) is larger than the largest address space %q", r.name, r.prefixLength, largest.String()))
+			return
+		}
+	}
+
+	result, err := allocateSubnets(spaces, requirements)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+func expandSubnetRequirements(input types.Dynamic) ([]subnetRequirement, error) {
+	var elements []attr.Value
+	switch v := input.UnderlyingValue().(type) {
+	case types.Tuple:
+		elements = v.Elements()
+	case types.List:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("subnets must be a list of objects")
+	}
+
+	result := make([]subnetRequirement, 0, len(elements))
+	names := make(map[string]struct{})
+	for i, element := range elements {
+		obj, ok := element.(types.Object)
+		if !ok {
+			return nil, fmt.Errorf("subnets[%d] must be an object", i)
+		}
+		attrs := obj.Attributes()
+
+		name, ok := attrs["name"].(types.String)
+		if !ok || name.IsNull() || name.ValueString() == "" {
+			return nil, fmt.Errorf("subnets[%d] must have a `name`", i)
+		}
+		requirement := subnetRequirement{
+			name: name.ValueString(),
+		}
+		if _, ok := names[requirement.name]; ok {
+			return nil, fmt.Errorf("subnets[%d]: the name %q is used more than once", i, requirement.name)
+		}
+		names[requirement.name] = struct{}{}
+
+		hostCount, hasHostCount, err := expandOptionalInt(attrs, "host_count")
+		if err != nil {
+			return nil, fmt.Errorf("subnets[%d]: %+v", i, err)
+		}
+		prefixLength, hasPrefixLength, err := expandOptionalInt(attrs, "prefix_length")
+		if err != nil {
+			return nil, fmt.Errorf("subnets[%d]: %+v", i, err)
+		}
+
+		switch {
+		case hasHostCount == hasPrefixLength:
+			return nil, fmt.Errorf("subnets[%d]: exactly one of `host_count` or `prefix_length` must be specified", i)
+		case hasHostCount:
+			// the largest subnet which can be allocated is a /1, which must also include the addresses reserved by Azure
+			if maximum := int64(1<<31) - azureReservedAddresses; hostCount < 1 || hostCount > maximum {
+				return nil, fmt.Errorf("subnets[%d]: `host_count` must be between 1 and %d, got %d", i, maximum, hostCount)
+			}
+			// the subnet must be large enough for the hosts and the addresses reserved by Azure
+			requirement.prefixLength = min(32-bits.Len64(uint64(hostCount+azureReservedAddresses-1)), smallestSubnetPrefixLength)
+		default:
+			if prefixLength < 1 || prefixLength > smallestSubnetPrefixLength {
+				return nil, fmt.Errorf("subnets[%d]: `prefix_length` must be between 1 and %d, got %d", i, smallestSubnetPrefixLength, prefixLength)
+			}
+			requirement.prefixLength = int(prefixLength)
+		}
+
+		maximum := smallestSubnetPrefixLength
+		if v, ok := subnetMaximumPrefixLengths[requirement.name]; ok {
+			maximum = v
+		}
+		if delegation, ok := attrs["delegation"].(types.String); ok && !delegation.IsNull() {
+			for service, v := range delegationMaximumPrefixLengths {
+				if strings.EqualFold(service, delegation.ValueString()) {
+					maximum = min(maximum, v)
+				}
+			}
+		}
+
+		if requirement.prefixLength > maximum {
+			if hasPrefixLength {
+				return nil, fmt.Errorf("subnets[%d]: the subnet %q must be at least a /%d, got /%d", i, requirement.name, maximum, requirement.prefixLength)
+			}
+			requirement.prefixLength = maximum
+		}
+
+		result = append(result, requirement)
+	}
+
+	return result, nil
+}
+
+func expandOptionalInt(attrs map[string]attr.Value, key string) (int64, bool, error) {
+	raw, ok := attrs[key]
+	if !ok || raw.IsNull() {
+		return 0, false, nil
+	}
+
+	v, ok := raw.(types.Number)
+	if !ok {
+		return 0, false, fmt.Errorf("`%s` must be a number", key)
+	}
+
+	i, accuracy := v.ValueBigFloat().Int64()
+	if accuracy != 0 {
+		return 0, false, fmt.Errorf("`%s` must be a whole number", key)
+	}
+
+	return i, true, nil
+}
+
+// allocateSubnets packs the subnets into the address spaces, largest first, so that each subnet is aligned to its size
+// without leaving gaps between subnets within an address space
+func allocateSubnets(spaces []netip.Prefix, requirements []subnetRequirement) (map[string]string, error) {
+	sorted := slices.Clone(requirements)
+	slices.SortStableFunc(sorted, func(a, b subnetRequirement) int {
+		return a.prefixLength - b.prefixLength
+	})
+
+	// the next free address within each address space, as an offset from the start of the space
+	next := make([]uint64, len(spaces))
+	result := make(map[string]string, len(requirements))
+
+	for _, r := range sorted {
+		size := uint64(1) << (32 - r.prefixLength)
+		allocated := false
+
+		for i, space := range spaces {
+			if r.prefixLength < space.Bits() {
+				continue
+			}
+
+			spaceSize := uint64(1) << (32 - space.Bits())
+			offset := (next[i] + size - 1) / size * size
+			if offset+size > spaceSize {
+				continue
+			}
+
+			start := addrToUint32(space.Addr()) + uint32(offset)
+			result[r.name] = netip.PrefixFrom(uint32ToAddr(start), r.prefixLength).String()
+			next[i] = offset + size
+			allocated = true
+			break
+		}
+
+		if !allocated {
+			remaining := uint64(0)
+			for i, space := range spaces {
+				remaining += (uint64(1) << (32 - space.Bits())) - next[i]
+			}
+			return nil, fmt.Errorf("unable to allocate the subnet %q (/%d) within the address space, %d addresses remain unallocated", r.name, r.prefixLength, remaining)
+		}
+	}
+
+	return result, nil
+}
+
+func addrToUint32(addr netip.Addr) uint32 {
+	b := addr.As4()
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
+
+func uint32ToAddr(v uint32) netip.Addr {
+	return netip.AddrFrom4([4]byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)})
+}
@@ -0,0 +1,227 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionAllocateSubnets_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAllocateSubnetsOutput(`["10.0.0.0/24"]`, `[
+    { name = "workload", host_count = 50 },
+    { name = "AzureFirewallSubnet", host_count = 10 },
+    { name = "GatewaySubnet", prefix_length = 27 },
+    { name = "apps", host_count = 3, delegation = "Microsoft.App/environments" },
+  ]`),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("workload", "10.0.0.0/26"),
+					acceptance.TestCheckOutput("AzureFirewallSubnet", "10.0.0.64/26"),
+					acceptance.TestCheckOutput("GatewaySubnet", "10.0.0.128/27"),
+					acceptance.TestCheckOutput("apps", "10.0.0.160/27"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionAllocateSubnets_multipleAddressSpaces(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAllocateSubnetsOutput(`["10.0.0.0/27", "10.1.0.0/24"]`, `[
+    { name = "small", host_count = 1 },
+    { name = "large", prefix_length = 25 },
+  ]`),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("large", "10.1.0.0/25"),
+					acceptance.TestCheckOutput("small", "10.0.0.0/29"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionAllocateSubnets_doesNotFit(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAllocateSubnetsOutput(`["10.0.0.0/26"]`, `[
+    { name = "AzureBastionSubnet", host_count = 10 },
+    { name = "workload", host_count = 1 },
+  ]`),
+				ExpectError: regexp.MustCompile(`unable to allocate the subnet "workload"`),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionAllocateSubnets_specialSubnetTooSmall(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAllocateSubnetsOutput(`["10.0.0.0/24"]`, `[
+    { name = "AzureFirewallSubnet", prefix_length = 28 },
+  ]`),
+				ExpectError: regexp.MustCompile(`must be at least a /26`),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionAllocateSubnets_overlappingAddressSpaces(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAllocateSubnetsOutput(`["10.0.0.0/16", "10.0.1.0/24"]`, `[
+    { name = "workload", host_count = 10 },
+  ]`),
+				ExpectError: regexp.MustCompile(`address space "10.0.1.0/24" overlaps with "10.0.0.0/16"`),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionAllocateSubnets_subnetLargerThanAddressSpace(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAllocateSubnetsOutput(`["10.0.0.0/24"]`, `[
+    { name = "workload", prefix_length = 23 },
+  ]`),
+				ExpectError: regexp.MustCompile(`the subnet "workload" \(/23\) is larger than the largest address space`),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionAllocateSubnets_hostCountTooLarge(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAllocateSubnetsOutput(`["0.0.0.0/1"]`, `[
+    { name = "workload", host_count = 2147483648 },
+  ]`),
+				ExpectError: regexp.MustCompile(`.host_count. must be between 1 and 2147483643`),
+			},
+		},
+	})
+}
+
+func testAllocateSubnetsOutput(addressSpace, subnets string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  subnets = provider::azurerm::allocate_subnets(%s, %s)
+}
+
+output "workload" {
+  value = lookup(local.subnets, "workload", "")
+}
+
+output "AzureFirewallSubnet" {
+  value = lookup(local.subnets, "AzureFirewallSubnet", "")
+}
+
+output "GatewaySubnet" {
+  value = lookup(local.subnets, "GatewaySubnet", "")
+}
+
+output "apps" {
+  value = lookup(local.subnets, "apps", "")
+}
+
+output "small" {
+  value = lookup(local.subnets, "small", "")
+}
+
+output "large" {
+  value = lookup(local.subnets, "large", "")
+}
+`, addressSpace, subnets)
+}
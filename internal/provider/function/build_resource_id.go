@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BuildResourceIDFunction struct{}
+
+var _ function.Function = BuildResourceIDFunction{}
+
+func NewBuildResourceIDFunction() function.Function {
+	return &BuildResourceIDFunction{}
+}
+
+func (b BuildResourceIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "build_resource_id"
+}
+
+func (b BuildResourceIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "build_resource_id",
+		Description:         "Builds a correctly cased Azure Resource Manager ID for a resource type supported by the provider from its components",
+		MarkdownDescription: "Builds a correctly cased Azure Resource Manager ID for a resource type supported by the provider from its components",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				Description:         "Full resource type, e.g. Microsoft.ApiManagement/service/gateways",
+				MarkdownDescription: "Full resource type, e.g. `Microsoft.ApiManagement/service/gateways`",
+			},
+			function.MapParameter{
+				Name:                "components",
+				Description:         "Map of the user specified segments of the ID, e.g. subscription_id, resource_group_name and service_name",
+				MarkdownDescription: "Map of the user specified segments of the ID, e.g. `subscription_id`, `resource_group_name` and `service_name`",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (b BuildResourceIDFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var resourceType string
+	var components map[string]string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &resourceType, &components))
+
+	if response.Error != nil {
+		return
+	}
+
+	if len(resourceType) == 0 {
+		response.Error = function.NewFuncError("Got empty resource type")
+		return
+	}
+
+	idTypes := resourceIdsOfType(resourceType)
+	if len(idTypes) == 0 {
+		response.Error = function.NewFuncError(fmt.Sprintf("the resource type %s is currently not supported in the provider", resourceType))
+		return
+	}
+
+	// the same resource type can be registered at different scopes, so use the first which is satisfied by the components
+	errs := make([]string, 0)
+	for _, idType := range idTypes {
+		result, err := buildResourceId(idType, components)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+
+		response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+		return
+	}
+
+	response.Error = function.NewFuncError(fmt.Sprintf("Building Resource ID Error: %s", strings.Join(errs, ", or ")))
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type IsResourceIDOfTypeFunction struct{}
+
+var _ function.Function = IsResourceIDOfTypeFunction{}
+
+func NewIsResourceIDOfTypeFunction() function.Function {
+	return &IsResourceIDOfTypeFunction{}
+}
+
+func (i IsResourceIDOfTypeFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "is_resource_id_of_type"
+}
+
+func (i IsResourceIDOfTypeFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "is_resource_id_of_type",
+		Description:         "Returns whether an Azure Resource Manager ID is a valid ID of the specified resource type",
+		MarkdownDescription: "Returns whether an Azure Resource Manager ID is a valid ID of the specified resource type",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				Description:         "Resource ID",
+				MarkdownDescription: "Resource ID",
+			},
+			function.StringParameter{
+				Name:                "type",
+				Description:         "Full resource type, e.g. Microsoft.ApiManagement/service/gateways",
+				MarkdownDescription: "Full resource type, e.g. `Microsoft.ApiManagement/service/gateways`",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (i IsResourceIDOfTypeFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var id, resourceType string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &id, &resourceType))
+
+	if response.Error != nil {
+		return
+	}
+
+	if len(resourceType) == 0 {
+		response.Error = function.NewFuncError("Got empty resource type")
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, isResourceIdOfType(id, resourceType)))
+}
+
+// isResourceIdOfType returns false rather than an error for malformed IDs, since this is intended for use in validation
+func isResourceIdOfType(id, resourceType string) bool {
+	idType := recaser.ResourceIdTypeFromResourceId(id)
+	if idType == nil {
+		return false
+	}
+
+	if !strings.EqualFold(strings.Trim(fullResourceType(idType), "/"), strings.Trim(resourceType, "/")) {
+		return false
+	}
+
+	// the casing of the static segments must be correct for the ID to be used by the provider
+	_, err := resourceids.NewParserFromResourceIdType(idType).Parse(id, false)
+	return err == nil
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+var isResourceIdOfTypeCases = map[string][]string{
+	"matching":          {"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/gateways/gateway1", "Microsoft.ApiManagement/service/gateways", "true"},
+	"insensitive_type":  {"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/gateways/gateway1", "microsoft.apimanagement/SERVICE/gateways", "true"},
+	"different_type":    {"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1", "Microsoft.ApiManagement/service/gateways", "false"},
+	"incorrect_casing":  {"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/gateways/gateway1", "Microsoft.ApiManagement/service/gateways", "false"},
+	"malformed":         {"not-a-resource-id", "Microsoft.ApiManagement/service/gateways", "false"},
+	"resource_group_id": {"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1", "resourceGroups", "true"},
+}
+
+func TestProviderFunctionIsResourceIDOfType_multiple(t *testing.T) {
+	t.Parallel()
+
+	checks := make([]resource.TestCheckFunc, 0, len(isResourceIdOfTypeCases))
+	for k, v := range isResourceIdOfTypeCases {
+		checks = append(checks, acceptance.TestCheckOutput(k, v[2]))
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testIsResourceIdOfTypeOutputs(isResourceIdOfTypeCases),
+				Check:  acceptance.ComposeTestCheckFunc(checks...),
+			},
+		},
+	})
+}
+
+func testIsResourceIdOfTypeOutputs(cases map[string][]string) string {
+	outputs := ""
+	for k, v := range cases {
+		outputs += fmt.Sprintf(`
+
+output "%s" {
+  value = provider::azurerm::is_resource_id_of_type("%s", "%s")
+}
+
+`, k, v[0], v[1])
+	}
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+`, outputs)
+}
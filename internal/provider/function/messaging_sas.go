@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// MessagingSASFunction computes a Shared Access Signature connection string for an Event Hubs or Service Bus entity,
+// which share the same connection string and SAS format
+type MessagingSASFunction struct {
+	// namespaceType is the prefix of the function name, e.g. `eventhub` for `eventhub_sas`
+	namespaceType string
+
+	// serviceName is the name of the service used in the descriptions, e.g. `Event Hub`
+	serviceName string
+
+	// resourceDescription describes the resource the SAS is computed for, e.g. `an Event Hub`
+	resourceDescription string
+}
+
+var _ function.Function = MessagingSASFunction{}
+
+func NewEventHubSASFunction() function.Function {
+	return &MessagingSASFunction{
+		namespaceType:       "eventhub",
+		serviceName:         "Event Hub",
+		resourceDescription: "an Event Hub",
+	}
+}
+
+func NewServiceBusSASFunction() function.Function {
+	return &MessagingSASFunction{
+		namespaceType:       "servicebus",
+		serviceName:         "Service Bus",
+		resourceDescription: "a Service Bus",
+	}
+}
+
+func (m MessagingSASFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = fmt.Sprintf("%s_sas", m.namespaceType)
+}
+
+func (m MessagingSASFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	description := fmt.Sprintf("Computes a Shared Access Signature connection string for %s from the connection string of an Authorization Rule", m.resourceDescription)
+
+	response.Definition = function.Definition{
+		Summary:             fmt.Sprintf("%s_sas", m.namespaceType),
+		Description:         description,
+		MarkdownDescription: description,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "connection_string",
+				Description:         fmt.Sprintf("The connection string of the %s Authorization Rule", m.serviceName),
+				MarkdownDescription: fmt.Sprintf("The connection string of the %s Authorization Rule", m.serviceName),
+			},
+			function.StringParameter{
+				Name:                "expiry",
+				Description:         "The expiry time of the SAS in RFC3339 format",
+				MarkdownDescription: "The expiry time of the SAS in RFC3339 format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (m MessagingSASFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var connectionString, expiry string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &connectionString, &expiry))
+
+	if response.Error != nil {
+		return
+	}
+
+	result, err := computeMessagingSASToken(connectionString, expiry)
+	if err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
@@ -7,7 +7,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -104,7 +103,6 @@ func (p ParseResourceIDFunction) Run(ctx context.Context, request function.RunRe
 	s := idType.Segments()
 	numSegments := len(s)
 	pTemp := ""
-	fullResourceType := ""
 	parentMap := map[string]string{}
 	for k, v := range s {
 		switch v.Type {
@@ -113,7 +111,6 @@ func (p ParseResourceIDFunction) Run(ctx context.Context, request function.RunRe
 
 		case resourceids.ResourceProviderSegmentType:
 			output["resource_provider"] = types.StringPointerValue(v.FixedValue)
-			fullResourceType = pointer.From(v.FixedValue)
 
 		case resourceids.SubscriptionIdSegmentType:
 			output["subscription_id"] = types.StringValue(parsed.Parsed["subscriptionId"])
@@ -123,12 +120,10 @@ func (p ParseResourceIDFunction) Run(ctx context.Context, request function.RunRe
 			case k == (numSegments - 2):
 				{
 					output["resource_type"] = types.StringPointerValue(v.FixedValue)
-					fullResourceType = fmt.Sprintf("%s/%s", fullResourceType, pointer.From(v.FixedValue))
 				}
 			case v.FixedValue != nil && *v.FixedValue != "subscriptions" && *v.FixedValue != "resourceGroups" && *v.FixedValue != "providers":
 				{
 					pTemp = parsed.Parsed[v.Name]
-					fullResourceType = fmt.Sprintf("%s/%s", fullResourceType, pointer.From(v.FixedValue))
 				}
 			}
 
@@ -148,7 +143,7 @@ func (p ParseResourceIDFunction) Run(ctx context.Context, request function.RunRe
 		return
 	}
 	output["parent_resources"] = parentMapValue
-	output["full_resource_type"] = types.StringValue(fullResourceType)
+	output["full_resource_type"] = types.StringValue(fullResourceType(idType))
 
 	result, diags := types.ObjectValue(idParseResultTypes, output)
 	if diags.HasError() {
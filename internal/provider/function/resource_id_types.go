@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+// fullResourceType returns the full type of a Resource ID as returned in `full_resource_type` from `parse_resource_id`,
+// e.g. `Microsoft.ApiManagement/service/gateways/hostnameConfigurations`. Resource IDs without a Resource Provider
+// segment have a leading `/`, e.g. `/resourceGroups`, so this should be trimmed when comparing types
+func fullResourceType(id resourceids.ResourceId) string {
+	s := id.Segments()
+	result := ""
+	for k, v := range s {
+		switch v.Type {
+		case resourceids.ResourceProviderSegmentType:
+			result = pointer.From(v.FixedValue)
+
+		case resourceids.StaticSegmentType:
+			switch {
+			case k == (len(s) - 2):
+				result = fmt.Sprintf("%s/%s", result, pointer.From(v.FixedValue))
+			case v.FixedValue != nil && *v.FixedValue != "subscriptions" && *v.FixedValue != "resourceGroups" && *v.FixedValue != "providers":
+				result = fmt.Sprintf("%s/%s", result, pointer.From(v.FixedValue))
+			}
+		}
+	}
+
+	return result
+}
+
+// resourceIdsOfType returns the Resource ID types registered in the provider which match the full resource type
+func resourceIdsOfType(resourceType string) []resourceids.ResourceId {
+	resourceType = strings.Trim(resourceType, "/")
+
+	keys := make([]string, 0)
+	for k, id := range recaser.KnownResourceIds() {
+		if strings.EqualFold(strings.Trim(fullResourceType(id), "/"), resourceType) {
+			keys = append(keys, k)
+		}
+	}
+
+	// intentionally sorted so that the result is consistent
+	sort.Strings(keys)
+
+	result := make([]resourceids.ResourceId, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, recaser.KnownResourceIds()[k])
+	}
+
+	return result
+}
+
+// componentKey normalises the name of an ID segment, so components can be specified as either `resourceGroupName`
+// or `resource_group_name`
+func componentKey(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// userSpecifiedSegmentNames returns the names of the segments in the Resource ID which must be provided as components
+func userSpecifiedSegmentNames(id resourceids.ResourceId) []string {
+	result := make([]string, 0)
+	for _, s := range id.Segments() {
+		switch s.Type {
+		case resourceids.StaticSegmentType, resourceids.ResourceProviderSegmentType:
+			continue
+		}
+		result = append(result, s.Name)
+	}
+
+	return result
+}
+
+// buildResourceId builds the Resource ID from its segments using the provided components, the casing of the
+// static segments comes from the Resource ID type and constant segments are normalised to their possible value
+func buildResourceId(id resourceids.ResourceId, components map[string]string) (string, error) {
+	values := make(map[string]string, len(components))
+	for k, v := range components {
+		values[componentKey(k)] = v
+	}
+
+	used := 0
+	segments := make([]string, 0)
+	for _, s := range id.Segments() {
+		switch s.Type {
+		case resourceids.StaticSegmentType, resourceids.ResourceProviderSegmentType:
+			segments = append(segments, pointer.From(s.FixedValue))
+			continue
+		}
+
+		v, ok := values[componentKey(s.Name)]
+		if !ok || v == "" {
+			return "", fmt.Errorf("missing a value for the component `%s`", s.Name)
+		}
+		used++
+
+		switch s.Type {
+		case resourceids.ScopeSegmentType:
+			v = strings.Trim(v, "/")
+
+		case resourceids.ConstantSegmentType:
+			found := false
+			for _, possible := range pointer.From(s.PossibleValues) {
+				if strings.EqualFold(v, possible) {
+					v, found = possible, true
+					break
+				}
+			}
+			if !found {
+				return "", fmt.Errorf("the component `%s` must be one of %q, got %q", s.Name, pointer.From(s.PossibleValues), v)
+			}
+		}
+
+		segments = append(segments, v)
+	}
+
+	if used != len(values) {
+		return "", fmt.Errorf("unexpected components were specified, expected only %q", userSpecifiedSegmentNames(id))
+	}
+
+	result := "/" + strings.Join(segments, "/")
+
+	// validate the result to catch values containing a `/` or an otherwise invalid combination of components
+	if _, err := resourceids.NewParserFromResourceIdType(id).Parse(result, false); err != nil {
+		return "", fmt.Errorf("built an invalid ID %q: %+v", result, err)
+	}
+
+	return result, nil
+}
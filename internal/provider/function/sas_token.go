@@ -0,0 +1,227 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/eventhub"
+	"github.com/hashicorp/go-azure-helpers/storage"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sas"
+)
+
+const (
+	// serviceSASSignedVersion matches the version used for the `azurerm_storage_account_blob_container_sas` data source
+	serviceSASSignedVersion = "2018-11-09"
+
+	// the permissions of a Service SAS must be specified in this order, these are the permissions defined by the signed
+	// version - newer permissions (e.g. `x` and `t`) require a newer signed version with a different string-to-sign
+	containerSASPermissions = "racwdl"
+	blobSASPermissions      = "racwd"
+	queueSASPermissions     = "raup"
+	tableSASPermissions     = "raud"
+)
+
+// storageAccountCredentials parses the Storage Account connection string, the underlying error isn't returned since it
+// contains the connection string
+func storageAccountCredentials(connectionString string) (accountName string, accountKey string, err error) {
+	kvp, err := storage.ParseAccountSASConnectionString(connectionString)
+	if err != nil {
+		return "", "", fmt.Errorf("the connection string is not a valid Storage Account connection string")
+	}
+
+	if kvp["AccountName"] == "" {
+		return "", "", fmt.Errorf("the connection string does not contain an `AccountName`")
+	}
+
+	return kvp["AccountName"], kvp["AccountKey"], nil
+}
+
+// normaliseSASCharacters validates that every character in input is supported, and returns them in the order required
+func normaliseSASCharacters(name, input, supported string) (string, error) {
+	if input == "" {
+		return "", fmt.Errorf("at least one of %q must be specified for the %s", supported, name)
+	}
+
+	for _, c := range input {
+		if !strings.ContainsRune(supported, c) {
+			return "", fmt.Errorf("%q is not a supported value for the %s, supported values are %q", c, name, supported)
+		}
+	}
+
+	return sas.OrderCharacters(input, supported), nil
+}
+
+// validateSASTimes checks the start and expiry are RFC3339 timestamps, as required by the SAS
+func validateSASTimes(start, expiry string) error {
+	startTime, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return fmt.Errorf("`start` must be an RFC3339 timestamp, got %q", start)
+	}
+
+	expiryTime, err := time.Parse(time.RFC3339, expiry)
+	if err != nil {
+		return fmt.Errorf("`expiry` must be an RFC3339 timestamp, got %q", expiry)
+	}
+
+	if !expiryTime.After(startTime) {
+		return fmt.Errorf("`expiry` must be after `start`")
+	}
+
+	return nil
+}
+
+func signSAS(accountKey, stringToSign string) (string, error) {
+	binaryKey, err := base64.StdEncoding.DecodeString(accountKey)
+	if err != nil {
+		return "", fmt.Errorf("decoding the account key: the key is not valid base64")
+	}
+
+	hasher := hmac.New(sha256.New, binaryKey)
+	hasher.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(hasher.Sum(nil)), nil
+}
+
+// computeBlobSASToken computes a Service SAS for a single Blob, this is built the same way as the Container SAS
+// computed by `storage.ComputeContainerSASToken` but for the `b` signed resource
+// See: https://learn.microsoft.com/en-us/rest/api/storageservices/create-service-sas
+func computeBlobSASToken(accountName, accountKey, containerName, blobName, permissions, start, expiry string) (string, error) {
+	signedResource := "b"
+	signedProtocol := "https"
+
+	stringToSign := strings.Join([]string{
+		permissions,
+		start,
+		expiry,
+		"/blob/" + accountName + "/" + containerName + "/" + blobName,
+		"", // signed identifier
+		"", // signed ip
+		signedProtocol,
+		serviceSASSignedVersion,
+		signedResource,
+		"", // signed snapshot time
+		"", // cache control
+		"", // content disposition
+		"", // content encoding
+		"", // content language
+		"", // content type
+	}, "\n")
+
+	signature, err := signSAS(accountKey, stringToSign)
+	if err != nil {
+		return "", err
+	}
+
+	sasToken := "?sv=" + serviceSASSignedVersion
+	sasToken += "&sr=" + signedResource
+	sasToken += "&st=" + start
+	sasToken += "&se=" + expiry
+	sasToken += "&sp=" + permissions
+	sasToken += "&spr=" + signedProtocol
+	sasToken += "&sig=" + url.QueryEscape(signature)
+
+	return sasToken, nil
+}
+
+// computeQueueSASToken computes a Service SAS for a Queue
+// See: https://learn.microsoft.com/en-us/rest/api/storageservices/create-service-sas
+func computeQueueSASToken(accountName, accountKey, queueName, permissions, start, expiry string) (string, error) {
+	signedProtocol := "https"
+
+	stringToSign := strings.Join([]string{
+		permissions,
+		start,
+		expiry,
+		"/queue/" + accountName + "/" + queueName,
+		"", // signed identifier
+		"", // signed ip
+		signedProtocol,
+		serviceSASSignedVersion,
+	}, "\n")
+
+	signature, err := signSAS(accountKey, stringToSign)
+	if err != nil {
+		return "", err
+	}
+
+	sasToken := "?sv=" + serviceSASSignedVersion
+	sasToken += "&st=" + start
+	sasToken += "&se=" + expiry
+	sasToken += "&sp=" + permissions
+	sasToken += "&spr=" + signedProtocol
+	sasToken += "&sig=" + url.QueryEscape(signature)
+
+	return sasToken, nil
+}
+
+// computeTableSASToken computes a Service SAS for a Table
+// See: https://learn.microsoft.com/en-us/rest/api/storageservices/create-service-sas
+func computeTableSASToken(accountName, accountKey, tableName, permissions, start, expiry string) (string, error) {
+	signedProtocol := "https"
+
+	stringToSign := strings.Join([]string{
+		permissions,
+		start,
+		expiry,
+		"/table/" + accountName + "/" + strings.ToLower(tableName),
+		"", // signed identifier
+		"", // signed ip
+		signedProtocol,
+		serviceSASSignedVersion,
+		"", // starting partition key
+		"", // starting row key
+		"", // ending partition key
+		"", // ending row key
+	}, "\n")
+
+	signature, err := signSAS(accountKey, stringToSign)
+	if err != nil {
+		return "", err
+	}
+
+	sasToken := "?sv=" + serviceSASSignedVersion
+	sasToken += "&tn=" + tableName
+	sasToken += "&st=" + start
+	sasToken += "&se=" + expiry
+	sasToken += "&sp=" + permissions
+	sasToken += "&spr=" + signedProtocol
+	sasToken += "&sig=" + url.QueryEscape(signature)
+
+	return sasToken, nil
+}
+
+// computeMessagingSASToken computes the SAS for an Event Hub or Service Bus connection string in the same way as the
+// `azurerm_eventhub_sas` data source, since both services use the same format
+func computeMessagingSASToken(connectionString, expiry string) (string, error) {
+	kvp, err := eventhub.ParseEventHubSASConnectionString(connectionString)
+	if err != nil {
+		return "", fmt.Errorf("the connection string is not a valid connection string")
+	}
+
+	if kvp["SharedAccessKeyName"] == "" {
+		return "", fmt.Errorf("the connection string does not contain a `SharedAccessKeyName`")
+	}
+
+	endpointUrl, err := eventhub.ComputeEventHubSASConnectionUrl(kvp["Endpoint"], kvp["EntityPath"])
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := time.Parse(time.RFC3339, expiry); err != nil {
+		return "", fmt.Errorf("`expiry` must be an RFC3339 timestamp, got %q", expiry)
+	}
+
+	sasToken, err := eventhub.ComputeEventHubSASToken(kvp["SharedAccessKeyName"], kvp["SharedAccessKey"], *endpointUrl, expiry)
+	if err != nil {
+		return "", err
+	}
+
+	return eventhub.ComputeEventHubSASConnectionString(sasToken), nil
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/storage"
+)
+
+const testSASAccountKey = "2vJrjEyL4re2nxCEg590wJUUC7PiqqrDHjAN5RU304FNUQieiEwS2bfp83O0v28iSfWjvYhkGmjYQAdd9x+6nw=="
+
+// testSASSignature checks that the signature within the SAS token is the signature of stringToSign
+func testSASSignature(t *testing.T, sasToken, stringToSign string) {
+	t.Helper()
+
+	query, err := url.ParseQuery(strings.TrimPrefix(sasToken, "?"))
+	if err != nil {
+		t.Fatalf("parsing %q: %+v", sasToken, err)
+	}
+
+	expected, err := signSAS(testSASAccountKey, stringToSign)
+	if err != nil {
+		t.Fatalf("signing: %+v", err)
+	}
+
+	if actual := query.Get("sig"); actual != expected {
+		t.Fatalf("expected the signature of %q to be %q but got %q", stringToSign, expected, actual)
+	}
+}
+
+func TestComputeBlobSASTokenStringToSign(t *testing.T) {
+	sasToken, err := computeBlobSASToken("azurermtestsa0", testSASAccountKey, "content", "file.txt", "rw", "2024-01-01T00:00:00Z", "2024-01-02T00:00:00Z")
+	if err != nil {
+		t.Fatalf("computing the SAS: %+v", err)
+	}
+
+	// the string-to-sign defined for the 2018-11-09 signed version
+	stringToSign := "rw\n" +
+		"2024-01-01T00:00:00Z\n" +
+		"2024-01-02T00:00:00Z\n" +
+		"/blob/azurermtestsa0/content/file.txt\n" +
+		"\n" + // signed identifier
+		"\n" + // signed ip
+		"https\n" +
+		"2018-11-09\n" +
+		"b\n" +
+		"\n" + // signed snapshot time
+		"\n" + // cache control
+		"\n" + // content disposition
+		"\n" + // content encoding
+		"\n" + // content language
+		"" // content type
+
+	testSASSignature(t, sasToken, stringToSign)
+}
+
+func TestComputeContainerSASTokenStringToSign(t *testing.T) {
+	sasToken, err := storage.ComputeContainerSASToken("rl", "2024-01-01T00:00:00Z", "2024-01-02T00:00:00Z", "azurermtestsa0", testSASAccountKey, "content", "", "", "https", "", "", "", "", "", "")
+	if err != nil {
+		t.Fatalf("computing the SAS: %+v", err)
+	}
+
+	if !strings.HasPrefix(sasToken, "?sv="+serviceSASSignedVersion+"&") {
+		t.Fatalf("expected the SAS to use the signed version %q, got %q", serviceSASSignedVersion, sasToken)
+	}
+
+	// the string-to-sign defined for the 2018-11-09 signed version
+	stringToSign := "rl\n" +
+		"2024-01-01T00:00:00Z\n" +
+		"2024-01-02T00:00:00Z\n" +
+		"/blob/azurermtestsa0/content\n" +
+		"\n" + // signed identifier
+		"\n" + // signed ip
+		"https\n" +
+		"2018-11-09\n" +
+		"c\n" +
+		"\n" + // signed snapshot time
+		"\n" + // cache control
+		"\n" + // content disposition
+		"\n" + // content encoding
+		"\n" + // content language
+		"" // content type
+
+	testSASSignature(t, sasToken, stringToSign)
+}
+
+func TestServiceSASPermissions(t *testing.T) {
+	testData := []struct {
+		Input     string
+		Supported string
+		Expected  string
+		Valid     bool
+	}{
+		{
+			Input:     "lr",
+			Supported: containerSASPermissions,
+			Expected:  "rl",
+			Valid:     true,
+		},
+		{
+			// `x` (execute) isn't defined by the signed version
+			Input:     "rx",
+			Supported: containerSASPermissions,
+			Valid:     false,
+		},
+		{
+			Input:     "wdr",
+			Supported: blobSASPermissions,
+			Expected:  "rwd",
+			Valid:     true,
+		},
+		{
+			// `l` only applies to Containers, and `t` (tags) isn't defined by the signed version
+			Input:     "rl",
+			Supported: blobSASPermissions,
+			Valid:     false,
+		},
+		{
+			Input:     "rt",
+			Supported: blobSASPermissions,
+			Valid:     false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := normaliseSASCharacters("permissions", v.Input, v.Supported)
+		if (err == nil) != v.Valid {
+			t.Fatalf("expected valid to be %t but got error %+v", v.Valid, err)
+		}
+		if v.Valid && actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
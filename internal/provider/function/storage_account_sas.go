@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/storage"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sas"
+)
+
+type StorageAccountSASFunction struct{}
+
+var _ function.Function = StorageAccountSASFunction{}
+
+func NewStorageAccountSASFunction() function.Function {
+	return &StorageAccountSASFunction{}
+}
+
+func (s StorageAccountSASFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "storage_account_sas"
+}
+
+func (s StorageAccountSASFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "storage_account_sas",
+		Description:         "Computes an Account SAS token for a Storage Account from its connection string",
+		MarkdownDescription: "Computes an Account SAS token for a Storage Account from its connection string",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "connection_string",
+				Description:         "The primary or secondary connection string of the Storage Account",
+				MarkdownDescription: "The primary or secondary connection string of the Storage Account",
+			},
+			function.StringParameter{
+				Name:                "services",
+				Description:         "The services the SAS can access, a combination of b (blob), q (queue), t (table) and f (file)",
+				MarkdownDescription: "The services the SAS can access, a combination of `b` (blob), `q` (queue), `t` (table) and `f` (file)",
+			},
+			function.StringParameter{
+				Name:                "resource_types",
+				Description:         "The resource types the SAS can access, a combination of s (service), c (container) and o (object)",
+				MarkdownDescription: "The resource types the SAS can access, a combination of `s` (service), `c` (container) and `o` (object)",
+			},
+			function.StringParameter{
+				Name:                "permissions",
+				Description:         "The permissions of the SAS, a combination of r, w, d, x, y, l, a, c, u, p, t, f and i",
+				MarkdownDescription: "The permissions of the SAS, a combination of `r`, `w`, `d`, `x`, `y`, `l`, `a`, `c`, `u`, `p`, `t`, `f` and `i`",
+			},
+			function.StringParameter{
+				Name:                "start",
+				Description:         "The start time of the SAS in RFC3339 format",
+				MarkdownDescription: "The start time of the SAS in RFC3339 format",
+			},
+			function.StringParameter{
+				Name:                "expiry",
+				Description:         "The expiry time of the SAS in RFC3339 format",
+				MarkdownDescription: "The expiry time of the SAS in RFC3339 format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (s StorageAccountSASFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var connectionString, services, resourceTypes, permissions, start, expiry string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &connectionString, &services, &resourceTypes, &permissions, &start, &expiry))
+
+	if response.Error != nil {
+		return
+	}
+
+	accountName, accountKey, err := storageAccountCredentials(connectionString)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	services, err = normaliseSASCharacters("services", services, sas.AccountSASServices)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resourceTypes, err = normaliseSASCharacters("resource types", resourceTypes, sas.AccountSASResourceTypes)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+
+	permissions, err = normaliseSASCharacters("permissions", permissions, sas.AccountSASPermissions)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(3, err.Error())
+		return
+	}
+
+	if err := validateSASTimes(start, expiry); err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result, err := storage.ComputeAccountSASToken(accountName, accountKey, permissions, services, resourceTypes, start, expiry, "https", "", sas.AccountSASSignedVersion, "")
+	if err != nil {
+		response.Error = function.NewFuncError(fmt.Sprintf("computing SAS token: %s", err))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
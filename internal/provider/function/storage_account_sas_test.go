@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionStorageAccountSAS_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testSASOutput(`provider::azurerm::storage_account_sas("DefaultEndpointsProtocol=https;AccountName=azurermtestsa0;AccountKey=2vJrjEyL4re2nxCEg590wJUUC7PiqqrDHjAN5RU304FNUQieiEwS2bfp83O0v28iSfWjvYhkGmjYQAdd9x+6nw==;EndpointSuffix=core.windows.net", "b", "ocs", "lr", "2024-01-01T00:00:00Z", "2024-01-02T00:00:00Z")`),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("sas", "?sv=2022-11-02&ss=b&srt=sco&sp=rl&se=2024-01-02T00:00:00Z&st=2024-01-01T00:00:00Z&spr=https&sig=VZMmX3x4NWRfJRXguoK8JyzfxIVo%2FUlpinNJXVRreYo%3D"),
+				),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionStorageAccountSAS_invalidPermissions(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testSASOutput(`provider::azurerm::storage_account_sas("DefaultEndpointsProtocol=https;AccountName=azurermtestsa0;AccountKey=2vJrjEyL4re2nxCEg590wJUUC7PiqqrDHjAN5RU304FNUQieiEwS2bfp83O0v28iSfWjvYhkGmjYQAdd9x+6nw==;EndpointSuffix=core.windows.net", "b", "sco", "rz", "2024-01-01T00:00:00Z", "2024-01-02T00:00:00Z")`),
+				ExpectError: regexp.MustCompile(`is not a supported value`),
+			},
+		},
+	})
+}
+
+func TestProviderFunctionStorageAccountSAS_invalidConnectionString(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: map[string]func() (tfprotov5.ProviderServer, error){
+			"azurerm": func() (tfprotov5.ProviderServer, error) { // nolint:unparam
+				return framework.V5ProviderWithoutPluginSDK()(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testSASOutput(`provider::azurerm::storage_account_sas("AccountName=example", "b", "sco", "r", "2024-01-01T00:00:00Z", "2024-01-02T00:00:00Z")`),
+				ExpectError: regexp.MustCompile(`not a valid Storage Account`),
+			},
+		},
+	})
+}
+
+func testSASOutput(expression string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+output "sas" {
+  value = %s
+}
+`, expression)
+}
@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+type StorageBlobSASFunction struct{}
+
+var _ function.Function = StorageBlobSASFunction{}
+
+func NewStorageBlobSASFunction() function.Function {
+	return &StorageBlobSASFunction{}
+}
+
+func (s StorageBlobSASFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "storage_blob_sas"
+}
+
+func (s StorageBlobSASFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "storage_blob_sas",
+		Description:         "Computes a Service SAS token for a Storage Blob from the connection string of its Storage Account",
+		MarkdownDescription: "Computes a Service SAS token for a Storage Blob from the connection string of its Storage Account",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "connection_string",
+				Description:         "The primary or secondary connection string of the Storage Account",
+				MarkdownDescription: "The primary or secondary connection string of the Storage Account",
+			},
+			function.StringParameter{
+				Name:                "container_name",
+				Description:         "The name of the Storage Container the Blob exists in",
+				MarkdownDescription: "The name of the Storage Container the Blob exists in",
+			},
+			function.StringParameter{
+				Name:                "blob_name",
+				Description:         "The name of the Storage Blob",
+				MarkdownDescription: "The name of the Storage Blob",
+			},
+			function.StringParameter{
+				Name:                "permissions",
+				Description:         "The permissions of the SAS, a combination of r, a, c, w and d",
+				MarkdownDescription: "The permissions of the SAS, a combination of `r`, `a`, `c`, `w` and `d`",
+			},
+			function.StringParameter{
+				Name:                "start",
+				Description:         "The start time of the SAS in RFC3339 format",
+				MarkdownDescription: "The start time of the SAS in RFC3339 format",
+			},
+			function.StringParameter{
+				Name:                "expiry",
+				Description:         "The expiry time of the SAS in RFC3339 format",
+				MarkdownDescription: "The expiry time of the SAS in RFC3339 format",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (s StorageBlobSASFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var connectionString, containerName, blobName, permissions, start, expiry string
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &connectionString, &containerName, &blobName, &permissions, &start, &expiry))
+
+	if response.Error != nil {
+		return
+	}
+
+	accountName, accountKey, err := storageAccountCredentials(connectionString)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	permissions, err = normaliseSASCharacters("permissions", permissions, blobSASPermissions)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(3, err.Error())
+		return
+	}
+
+	if err := validateSASTimes(start, expiry); err != nil {
+		response.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result, err := computeBlobSASToken(accountName, accountKey, containerName, blobName, permissions, start, expiry)
+	if err != nil {
+		response.Error = function.NewFuncError(fmt.Sprintf("computing SAS token: %s", err))
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
@@ -211,6 +211,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		paloalto.Registration{},
 		policy.Registration{},
 		postgres.Registration{},
+		privatedns.Registration{},
 		privatednsresolver.Registration{},
 		qumulo.Registration{},
 		recoveryservices.Registration{},
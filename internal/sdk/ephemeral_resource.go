@@ -5,8 +5,11 @@ package sdk
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -33,6 +36,14 @@ type EphemeralResourceWithRenew interface {
 	ephemeral.EphemeralResourceWithRenew
 }
 
+const (
+	// EphemeralResourceRenewMargin is the time before the value of an Ephemeral Resource expires at which Terraform
+	// will call Renew
+	EphemeralResourceRenewMargin = 5 * time.Minute
+
+	ephemeralResourcePrivateStateExpiryKey = "expiry"
+)
+
 type EphemeralResourceWithConfigurationValidation interface {
 	EphemeralResource
 
@@ -71,3 +82,62 @@ func (r *EphemeralResourceMetadata) DecodeOpen(ctx context.Context, req ephemera
 
 	return !resp.Diagnostics.HasError()
 }
+
+// SetEphemeralResourceExpiry stores when the value of an Ephemeral Resource expires so that it can be re-validated by
+// RenewEphemeralResourceExpiry, which Terraform calls shortly before this time.
+func SetEphemeralResourceExpiry(ctx context.Context, resp *ephemeral.OpenResponse, expiry time.Time) {
+	if expiry.IsZero() {
+		return
+	}
+
+	v, err := json.Marshal(expiry.UTC().Format(time.RFC3339))
+	if err != nil {
+		resp.Diagnostics.AddError("storing the expiry", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, ephemeralResourcePrivateStateExpiryKey, v)...)
+	resp.RenewAt = expiry.Add(-EphemeralResourceRenewMargin)
+}
+
+// RenewEphemeralResourceExpiry re-validates the expiry stored by SetEphemeralResourceExpiry. The value of an Ephemeral
+// Resource can't change once it's been opened, so Renew can't issue a new credential to the resources already using it.
+// Instead a warning is raised shortly before the value expires and Renew is scheduled again for the expiry, at which
+// point an error is raised - making it clear why requests using the value fail. The name describes the value, e.g. `SAS`.
+func RenewEphemeralResourceExpiry(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse, name string) {
+	raw, diags := req.Private.GetKey(ctx, ephemeralResourcePrivateStateExpiryKey)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || raw == nil {
+		return
+	}
+
+	var v string
+	if err := json.Unmarshal(raw, &v); err != nil {
+		resp.Diagnostics.AddError("reading the expiry", err.Error())
+		return
+	}
+
+	expiry, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		resp.Diagnostics.AddError("parsing the expiry", err.Error())
+		return
+	}
+
+	renewAt, renewDiags := renewExpiry(name, expiry, time.Now())
+	resp.Diagnostics.Append(renewDiags...)
+	resp.RenewAt = renewAt
+}
+
+// renewExpiry returns when Renew should next be called for a value which expires at expiry, along with the diagnostics
+// to raise at now
+func renewExpiry(name string, expiry time.Time, now time.Time) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !now.Before(expiry) {
+		diags.AddError(fmt.Sprintf("%s has expired", name), fmt.Sprintf("the %s expired at %s and can't be renewed during this operation, requests made using it will fail - increase the validity if the operation takes longer than this", name, expiry.Format(time.RFC3339)))
+		return time.Time{}, diags
+	}
+
+	diags.AddWarning(fmt.Sprintf("%s is about to expire", name), fmt.Sprintf("the %s expires at %s and can't be renewed during this operation, requests made using it after this time will fail", name, expiry.Format(time.RFC3339)))
+	return expiry, diags
+}
@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRenewExpiry(t *testing.T) {
+	expiry := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	testData := []struct {
+		Name            string
+		Now             time.Time
+		ExpectedRenewAt time.Time
+		ExpectError     bool
+	}{
+		{
+			Name:            "about to expire",
+			Now:             expiry.Add(-EphemeralResourceRenewMargin),
+			ExpectedRenewAt: expiry,
+		},
+		{
+			Name:        "expired",
+			Now:         expiry,
+			ExpectError: true,
+		},
+		{
+			Name:        "expired some time ago",
+			Now:         expiry.Add(time.Hour),
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		renewAt, diags := renewExpiry("SAS", expiry, v.Now)
+		if diags.HasError() != v.ExpectError {
+			t.Fatalf("expected an error to be %t but got %+v", v.ExpectError, diags)
+		}
+		if !v.ExpectError && diags.WarningsCount() != 1 {
+			t.Fatalf("expected a warning but got %+v", diags)
+		}
+		if !renewAt.Equal(v.ExpectedRenewAt) {
+			t.Fatalf("expected Renew at %s but got %s", v.ExpectedRenewAt, renewAt)
+		}
+	}
+}
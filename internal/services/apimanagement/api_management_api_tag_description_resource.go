@@ -132,7 +132,7 @@ func resourceApiManagementApiTagDescriptionRead(d *pluginsdk.ResourceData, meta
 	newId := apitagdescription.NewTagDescriptionID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, apiName, id.TagDescriptionId)
 	resp, err := client.Get(ctx, newId)
 	if err != nil {
-		if !response.WasNotFound(resp.HttpResponse) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] %s was not found - removing from state!", newId)
 			d.SetId("")
 			return nil
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/namedvalue"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -61,7 +62,7 @@ func resourceApiManagementNamedValue() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeList,
 				Optional:     true,
 				MaxItems:     1,
-				ExactlyOneOf: []string{"value", "value_from_key_vault"},
+				ExactlyOneOf: []string{"value", "value_wo", "value_from_key_vault"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"secret_id": {
@@ -84,7 +85,22 @@ func resourceApiManagementNamedValue() *pluginsdk.Resource {
 				Optional:     true,
 				Sensitive:    true,
 				ValidateFunc: validation.StringIsNotEmpty,
-				ExactlyOneOf: []string{"value", "value_from_key_vault"},
+				ExactlyOneOf: []string{"value", "value_wo", "value_from_key_vault"},
+			},
+
+			"value_wo": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"value_wo_version"},
+				ExactlyOneOf: []string{"value", "value_wo", "value_from_key_vault"},
+			},
+
+			"value_wo_version": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
 			},
 
 			"secret": {
@@ -149,6 +165,14 @@ func resourceApiManagementNamedValueCreateUpdate(d *pluginsdk.ResourceData, meta
 		parameters.Properties.Value = pointer.To(v.(string))
 	}
 
+	woValue, err := pluginsdk.GetWriteOnly(d, "value_wo", cty.String)
+	if err != nil {
+		return err
+	}
+	if !woValue.IsNull() {
+		parameters.Properties.Value = pointer.To(woValue.AsString())
+	}
+
 	if tags, ok := d.GetOk("tags"); ok {
 		parameters.Properties.Tags = utils.ExpandStringSlice(tags.([]interface{}))
 	}
@@ -197,7 +221,8 @@ func resourceApiManagementNamedValueRead(d *pluginsdk.ResourceData, meta interfa
 			d.Set("display_name", props.DisplayName)
 			d.Set("secret", pointer.From(props.Secret))
 			// API will not return `value` when `secret` is `true`, in which case we shall not set the `value`. Refer to the issue : #6688
-			if props.Secret != nil && !*props.Secret {
+			// when `value_wo` is used the value must not be persisted into the state
+			if props.Secret != nil && !*props.Secret && d.Get("value_wo_version").(int) == 0 {
 				d.Set("value", pointer.From(props.Value))
 			}
 			if err := d.Set("value_from_key_vault", flattenApiManagementNamedValueKeyVault(props.KeyVault)); err != nil {
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type AppServiceRestartAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &AppServiceRestartAction{}
+
+func newAppServiceRestartAction() action.Action {
+	return &AppServiceRestartAction{}
+}
+
+type AppServiceRestartActionModel struct {
+	AppId       types.String `tfsdk:"app_id"`
+	SlotId      types.String `tfsdk:"slot_id"`
+	SoftRestart types.Bool   `tfsdk:"soft_restart"`
+	Timeout     types.String `tfsdk:"timeout"`
+}
+
+func (a *AppServiceRestartAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Web App or Function App to restart.",
+				MarkdownDescription: "The ID of the Web App or Function App to restart.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateAppServiceID,
+					},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("app_id"), path.MatchRoot("slot_id")),
+				},
+			},
+
+			"slot_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Web App Slot or Function App Slot to restart.",
+				MarkdownDescription: "The ID of the Web App Slot or Function App Slot to restart.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: webapps.ValidateSlotID,
+					},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("app_id"), path.MatchRoot("slot_id")),
+				},
+			},
+
+			"soft_restart": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Should the configuration of the app be reapplied without restarting the underlying workers? Defaults to `false`.",
+				MarkdownDescription: "Should the configuration of the app be reapplied without restarting the underlying workers? Defaults to `false`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the restart to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the restart to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (a *AppServiceRestartAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_app_service_restart"
+}
+
+func (a *AppServiceRestartAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.AppService.WebAppsClient
+
+	model := AppServiceRestartActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	softRestart := model.SoftRestart.ValueBool()
+
+	if v := model.SlotId.ValueString(); v != "" {
+		id, err := webapps.ParseSlotID(v)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("restarting %s", id),
+		})
+
+		options := webapps.RestartSlotOperationOptions{
+			SoftRestart: pointer.To(softRestart),
+			Synchronous: pointer.To(true),
+		}
+		if _, err := client.RestartSlot(ctx, *id, options); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("restarting %s: %+v", id, err))
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("restart of %s completed", id.SlotName),
+		})
+		return
+	}
+
+	id, err := commonids.ParseAppServiceID(model.AppId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("restarting %s", id),
+	})
+
+	options := webapps.RestartOperationOptions{
+		SoftRestart: pointer.To(softRestart),
+		Synchronous: pointer.To(true),
+	}
+	if _, err := client.Restart(ctx, *id, options); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("restarting %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("restart of %s completed", id.SiteName),
+	})
+}
+
+func (a *AppServiceRestartAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type AppServiceRestartAction struct{}
+
+func TestAccAppServiceRestartAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_restart", "test")
+	a := AppServiceRestartAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func TestAccAppServiceRestartAction_softRestartSlot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_restart", "test")
+	a := AppServiceRestartAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.softRestartSlot(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *AppServiceRestartAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_linux_web_app.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_app_service_restart.test]
+    }
+  }
+}
+
+action "azurerm_app_service_restart" "test" {
+  config {
+    app_id = azurerm_linux_web_app.test.id
+  }
+}
+`, LinuxWebAppResource{}.basic(data))
+}
+
+func (a *AppServiceRestartAction) softRestartSlot(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_linux_web_app_slot.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_app_service_restart.test]
+    }
+  }
+}
+
+action "azurerm_app_service_restart" "test" {
+  config {
+    slot_id      = azurerm_linux_web_app_slot.test.id
+    soft_restart = true
+  }
+}
+`, LinuxWebAppSlotResource{}.basic(data))
+}
@@ -0,0 +1,221 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/custompollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+)
+
+const (
+	appServiceSlotSwapPhasePreview  = "preview"
+	appServiceSlotSwapPhaseComplete = "complete"
+	appServiceSlotSwapPhaseCancel   = "cancel"
+
+	appServiceProductionSlotName = "production"
+)
+
+type AppServiceSlotSwapAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &AppServiceSlotSwapAction{}
+
+func newAppServiceSlotSwapAction() action.Action {
+	return &AppServiceSlotSwapAction{}
+}
+
+type AppServiceSlotSwapActionModel struct {
+	SlotId                 types.String `tfsdk:"slot_id"`
+	TargetSlotName         types.String `tfsdk:"target_slot_name"`
+	Phase                  types.String `tfsdk:"phase"`
+	OverwriteNetworkConfig types.Bool   `tfsdk:"overwrite_network_config"`
+	Timeout                types.String `tfsdk:"timeout"`
+}
+
+func (a *AppServiceSlotSwapAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"slot_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Web App Slot or Function App Slot to swap.",
+				MarkdownDescription: "The ID of the Web App Slot or Function App Slot to swap.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: webapps.ValidateSlotID,
+					},
+				},
+			},
+
+			"target_slot_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name of the Slot to swap with. Defaults to `production`.",
+				MarkdownDescription: "The name of the Slot to swap with. Defaults to `production`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.WebAppName,
+					},
+				},
+			},
+
+			"phase": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The phase of the swap to perform. Possible values are `preview`, which applies the configuration of the target slot to the source slot, `complete` and `cancel`. Defaults to `complete`.",
+				MarkdownDescription: "The phase of the swap to perform. Possible values are `preview`, which applies the configuration of the target slot to the source slot, `complete` and `cancel`. Defaults to `complete`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						appServiceSlotSwapPhasePreview,
+						appServiceSlotSwapPhaseComplete,
+						appServiceSlotSwapPhaseCancel,
+					),
+				},
+			},
+
+			"overwrite_network_config": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Should the network configuration of the target slot be overwritten with the configuration from the source slot? Defaults to `true`.",
+				MarkdownDescription: "Should the network configuration of the target slot be overwritten with the configuration from the source slot? Defaults to `true`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the swap to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the swap to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (a *AppServiceSlotSwapAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_app_service_slot_swap"
+}
+
+func (a *AppServiceSlotSwapAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.AppService.WebAppsClient
+
+	model := AppServiceSlotSwapActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := webapps.ParseSlotID(model.SlotId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+	appId := commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroupName, id.SiteName)
+
+	targetSlotName := appServiceProductionSlotName
+	if v := model.TargetSlotName.ValueString(); v != "" {
+		targetSlotName = v
+	}
+
+	phase := appServiceSlotSwapPhaseComplete
+	if v := model.Phase.ValueString(); v != "" {
+		phase = v
+	}
+
+	// Note: This setting controls the ambiguously named `PreserveVnet`
+	overwriteNetworkConfig := true
+	if !model.OverwriteNetworkConfig.IsNull() {
+		overwriteNetworkConfig = model.OverwriteNetworkConfig.ValueBool()
+	}
+
+	locks.ByID(appId.ID())
+	defer locks.UnlockByID(appId.ID())
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("running the %s phase of the swap of %s with %s", phase, id.SlotName, targetSlotName),
+	})
+
+	if err := appServiceSlotSwap(ctx, client, *id, targetSlotName, phase, overwriteNetworkConfig); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%s phase of the swap of %s with %s completed", phase, id.SlotName, targetSlotName),
+	})
+}
+
+func (a *AppServiceSlotSwapAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
+
+// appServiceSlotSwap runs the specified phase of the swap of the Slot `id` with the Slot `targetSlotName`
+func appServiceSlotSwap(ctx context.Context, client *webapps.WebAppsClient, id webapps.SlotId, targetSlotName string, phase string, preserveVnet bool) error {
+	appId := commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroupName, id.SiteName)
+	toProduction := strings.EqualFold(targetSlotName, appServiceProductionSlotName)
+	targetSlotId := webapps.NewSlotID(id.SubscriptionId, id.ResourceGroupName, id.SiteName, targetSlotName)
+
+	switch phase {
+	case appServiceSlotSwapPhasePreview:
+		// the configuration of the target slot is applied to the source slot, which is then warmed up ready for the swap
+		// to be completed or cancelled - this is the case for production too, since `ApplySlotConfigToProduction` would
+		// instead apply the configuration of the source slot to production
+		if _, err := client.ApplySlotConfigurationSlot(ctx, id, webapps.CsmSlotEntity{TargetSlot: targetSlotName, PreserveVnet: preserveVnet}); err != nil {
+			return fmt.Errorf("applying the configuration of %s to %s: %+v", targetSlotId, id, err)
+		}
+
+	case appServiceSlotSwapPhaseComplete:
+		if toProduction {
+			if _, err := client.SwapSlotWithProduction(ctx, appId, webapps.CsmSlotEntity{TargetSlot: id.SlotName, PreserveVnet: preserveVnet}); err != nil {
+				return fmt.Errorf("swapping %s with %s: %+v", id, appId, err)
+			}
+
+			pollerType := custompollers.NewAppServiceActiveSlotPoller(client, appId, id)
+			poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+			if err := poller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for the swap of %s with %s: %+v", id, appId, err)
+			}
+		} else {
+			if err := client.SwapSlotSlotThenPoll(ctx, id, webapps.CsmSlotEntity{TargetSlot: targetSlotName, PreserveVnet: preserveVnet}); err != nil {
+				return fmt.Errorf("swapping %s with %s: %+v", id, targetSlotId, err)
+			}
+		}
+
+	case appServiceSlotSwapPhaseCancel:
+		// only the configuration of the source slot is changed by the preview phase, so this is all that's reset
+		if _, err := client.ResetSlotConfigurationSlot(ctx, id); err != nil {
+			return fmt.Errorf("resetting the configuration of %s: %+v", id, err)
+		}
+
+	default:
+		return fmt.Errorf("unsupported phase %q", phase)
+	}
+
+	return nil
+}
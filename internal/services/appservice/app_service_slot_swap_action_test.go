@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type AppServiceSlotSwapAction struct{}
+
+func TestAccAppServiceSlotSwapAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_slot_swap", "test")
+	a := AppServiceSlotSwapAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func TestAccAppServiceSlotSwapAction_previewAndCancel(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_app_service_slot_swap", "test")
+	a := AppServiceSlotSwapAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.previewAndCancel(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *AppServiceSlotSwapAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_linux_web_app_slot.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_app_service_slot_swap.test]
+    }
+  }
+}
+
+action "azurerm_app_service_slot_swap" "test" {
+  config {
+    slot_id = azurerm_linux_web_app_slot.test.id
+  }
+}
+`, LinuxWebAppSlotResource{}.basic(data))
+}
+
+func (a *AppServiceSlotSwapAction) previewAndCancel(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_linux_web_app_slot.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_app_service_slot_swap.preview, action.azurerm_app_service_slot_swap.cancel]
+    }
+  }
+}
+
+action "azurerm_app_service_slot_swap" "preview" {
+  config {
+    slot_id = azurerm_linux_web_app_slot.test.id
+    phase   = "preview"
+  }
+}
+
+action "azurerm_app_service_slot_swap" "cancel" {
+  config {
+    slot_id = azurerm_linux_web_app_slot.test.id
+    phase   = "cancel"
+  }
+}
+`, LinuxWebAppSlotResource{}.basic(data))
+}
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+func TestAppServiceSlotSwapEndpoints(t *testing.T) {
+	appPath := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1"
+
+	testData := []struct {
+		Phase      string
+		TargetSlot string
+		Expected   []string
+	}{
+		{
+			Phase:      appServiceSlotSwapPhasePreview,
+			TargetSlot: "production",
+			Expected:   []string{"POST " + appPath + "/slots/staging/applySlotConfig"},
+		},
+		{
+			Phase:      appServiceSlotSwapPhasePreview,
+			TargetSlot: "other",
+			Expected:   []string{"POST " + appPath + "/slots/staging/applySlotConfig"},
+		},
+		{
+			Phase:      appServiceSlotSwapPhaseComplete,
+			TargetSlot: "production",
+			Expected:   []string{"POST " + appPath + "/slotsswap"},
+		},
+		{
+			Phase:      appServiceSlotSwapPhaseComplete,
+			TargetSlot: "other",
+			Expected:   []string{"POST " + appPath + "/slots/staging/slotsswap"},
+		},
+		{
+			Phase:      appServiceSlotSwapPhaseCancel,
+			TargetSlot: "production",
+			Expected:   []string{"POST " + appPath + "/slots/staging/resetSlotConfig"},
+		},
+		{
+			Phase:      appServiceSlotSwapPhaseCancel,
+			TargetSlot: "other",
+			Expected:   []string{"POST " + appPath + "/slots/staging/resetSlotConfig"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing the %q phase with the %q slot", v.Phase, v.TargetSlot)
+
+		var mu sync.Mutex
+		actual := make([]string, 0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.Method == http.MethodGet {
+				// only the requests which change the slots are recorded, the swap is polled for using GET requests and
+				// is complete once the source slot is reported as the swapped slot
+				w.Write([]byte(`{"properties": {"slotSwapStatus": {"sourceSlotName": "staging"}}}`))
+				return
+			}
+
+			mu.Lock()
+			actual = append(actual, r.Method+" "+r.URL.Path)
+			mu.Unlock()
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		c, err := resourcemanager.NewClient(environments.NewApiEndpoint("test", server.URL, nil), "webapps", "2023-12-01")
+		if err != nil {
+			server.Close()
+			t.Fatalf("building client: %+v", err)
+		}
+		c.AuthorizeRequest = nil
+		client := &webapps.WebAppsClient{Client: c}
+
+		ctx, cancel := context.WithTimeout(pollers.WithSkipPollingDelay(context.Background()), time.Minute)
+		id := webapps.NewSlotID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "staging")
+		err = appServiceSlotSwap(ctx, client, id, v.TargetSlot, v.Phase, true)
+		cancel()
+		server.Close()
+		if err != nil {
+			t.Fatalf("running the %q phase: %+v", v.Phase, err)
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected the requests %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
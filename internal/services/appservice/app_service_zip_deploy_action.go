@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AppServiceZipDeployAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &AppServiceZipDeployAction{}
+
+func newAppServiceZipDeployAction() action.Action {
+	return &AppServiceZipDeployAction{}
+}
+
+type AppServiceZipDeployActionModel struct {
+	AppId         types.String `tfsdk:"app_id"`
+	SlotId        types.String `tfsdk:"slot_id"`
+	ZipDeployFile types.String `tfsdk:"zip_deploy_file"`
+	Timeout       types.String `tfsdk:"timeout"`
+}
+
+func (a *AppServiceZipDeployAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Web App or Function App to deploy the package to.",
+				MarkdownDescription: "The ID of the Web App or Function App to deploy the package to.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateAppServiceID,
+					},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("app_id"), path.MatchRoot("slot_id")),
+				},
+			},
+
+			"slot_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Web App Slot or Function App Slot to deploy the package to.",
+				MarkdownDescription: "The ID of the Web App Slot or Function App Slot to deploy the package to.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: webapps.ValidateSlotID,
+					},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("app_id"), path.MatchRoot("slot_id")),
+				},
+			},
+
+			"zip_deploy_file": schema.StringAttribute{
+				Required:            true,
+				Description:         "The local path and filename of the Zip package to deploy.",
+				MarkdownDescription: "The local path and filename of the Zip package to deploy.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the deployment to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the deployment to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (a *AppServiceZipDeployAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_app_service_zip_deploy"
+}
+
+func (a *AppServiceZipDeployAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.AppService.WebAppsClient
+
+	model := AppServiceZipDeployActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	sourceFile := model.ZipDeployFile.ValueString()
+
+	if v := model.SlotId.ValueString(); v != "" {
+		id, err := webapps.ParseSlotID(v)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("deploying %s to %s", sourceFile, id),
+		})
+
+		if err := helpers.GetCredentialsAndPublishSlot(ctx, client, *id, sourceFile); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", err)
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("deployment to %s completed", id.SlotName),
+		})
+		return
+	}
+
+	id, err := commonids.ParseAppServiceID(model.AppId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("deploying %s to %s", sourceFile, id),
+	})
+
+	if err := helpers.GetCredentialsAndPublish(ctx, client, *id, sourceFile); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("deployment to %s completed", id.SiteName),
+	})
+}
+
+func (a *AppServiceZipDeployAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
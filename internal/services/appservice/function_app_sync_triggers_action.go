@@ -0,0 +1,139 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type FunctionAppSyncTriggersAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &FunctionAppSyncTriggersAction{}
+
+func newFunctionAppSyncTriggersAction() action.Action {
+	return &FunctionAppSyncTriggersAction{}
+}
+
+type FunctionAppSyncTriggersActionModel struct {
+	FunctionAppId types.String `tfsdk:"function_app_id"`
+	SlotId        types.String `tfsdk:"slot_id"`
+	Timeout       types.String `tfsdk:"timeout"`
+}
+
+func (f *FunctionAppSyncTriggersAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_app_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Function App whose triggers should be synchronised.",
+				MarkdownDescription: "The ID of the Function App whose triggers should be synchronised.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateFunctionAppID,
+					},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("function_app_id"), path.MatchRoot("slot_id")),
+				},
+			},
+
+			"slot_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Function App Slot whose triggers should be synchronised.",
+				MarkdownDescription: "The ID of the Function App Slot whose triggers should be synchronised.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: webapps.ValidateSlotID,
+					},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("function_app_id"), path.MatchRoot("slot_id")),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the triggers to be synchronised. Defaults to `5m`.",
+				MarkdownDescription: "Timeout duration for the triggers to be synchronised. Defaults to `5m`.",
+			},
+		},
+	}
+}
+
+func (f *FunctionAppSyncTriggersAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_function_app_sync_triggers"
+}
+
+func (f *FunctionAppSyncTriggersAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := f.Client.AppService.WebAppsClient
+
+	model := FunctionAppSyncTriggersActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 5 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	if v := model.SlotId.ValueString(); v != "" {
+		id, err := webapps.ParseSlotID(v)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+			return
+		}
+
+		if _, err := client.SyncFunctionTriggersSlot(ctx, *id); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("synchronising the triggers of %s: %+v", id, err))
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("triggers of %s synchronised", id.SlotName),
+		})
+		return
+	}
+
+	id, err := commonids.ParseFunctionAppID(model.FunctionAppId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	if _, err := client.SyncFunctionTriggers(ctx, *id); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("synchronising the triggers of %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("triggers of %s synchronised", id.SiteName),
+	})
+}
+
+func (f *FunctionAppSyncTriggersAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	f.Defaults(ctx, request, response)
+}
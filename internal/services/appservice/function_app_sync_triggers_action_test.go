@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type FunctionAppSyncTriggersAction struct{}
+
+func TestAccFunctionAppSyncTriggersAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_sync_triggers", "test")
+	a := FunctionAppSyncTriggersAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func TestAccFunctionAppSyncTriggersAction_slot(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_sync_triggers", "test")
+	a := FunctionAppSyncTriggersAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.slot(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *FunctionAppSyncTriggersAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_linux_function_app.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_function_app_sync_triggers.test]
+    }
+  }
+}
+
+action "azurerm_function_app_sync_triggers" "test" {
+  config {
+    function_app_id = azurerm_linux_function_app.test.id
+  }
+}
+`, LinuxFunctionAppResource{}.basic(data, SkuStandardPlan))
+}
+
+func (a *FunctionAppSyncTriggersAction) slot(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_linux_function_app_slot.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_function_app_sync_triggers.test]
+    }
+  }
+}
+
+action "azurerm_function_app_sync_triggers" "test" {
+  config {
+    slot_id = azurerm_linux_function_app_slot.test.id
+  }
+}
+`, LinuxFunctionAppSlotResource{}.basic(data, SkuStandardPlan))
+}
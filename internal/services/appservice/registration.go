@@ -69,7 +69,12 @@ func (r Registration) Resources() []sdk.Resource {
 }
 
 func (r Registration) Actions() []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		newAppServiceRestartAction,
+		newAppServiceSlotSwapAction,
+		newAppServiceZipDeployAction,
+		newFunctionAppSyncTriggersAction,
+	}
 }
 
 func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type AccessTokenEphemeral struct{}
+
+func TestAccEphemeralAccessToken_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_access_token", "test")
+	r := AccessTokenEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.StringRegexp(regexp.MustCompile(`^[\w-]+\.[\w-]+\.[\w-]+$`))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("tenant_id"), knownvalue.StringExact(data.Client().TenantID)),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("expires_on"), knownvalue.StringRegexp(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccEphemeralAccessToken_invalidTenant(t *testing.T) {
+	r := AccessTokenEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config:      r.tenant("00000000-0000-0000-0000-000000000000"),
+				ExpectError: regexp.MustCompile("must be either the tenant"),
+			},
+		},
+	})
+}
+
+func (AccessTokenEphemeral) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+ephemeral "azurerm_access_token" "test" {
+  scope = "https://management.azure.com/.default"
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_access_token.test
+}
+
+resource "echo" "test" {}
+`
+}
+
+func (AccessTokenEphemeral) tenant(tenantId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+ephemeral "azurerm_access_token" "test" {
+  scope     = "https://management.azure.com/.default"
+  tenant_id = %q
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_access_token.test
+}
+
+resource "echo" "test" {}
+`, tenantId)
+}
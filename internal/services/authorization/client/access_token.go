@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/validate"
+)
+
+type AccessToken struct {
+	Token     string
+	ExpiresOn time.Time
+}
+
+// AccessToken obtains an access token for the specified scope using the credentials the Provider is configured with.
+// The scope can be either a resource identifier (e.g. `https://database.windows.net`) or a `/.default` scope, since the
+// token is always requested for the `/.default` scope of the resource. When tenantId is specified it must be either the
+// tenant the Provider is configured with or one of the auxiliary tenants.
+func (c Client) AccessToken(ctx context.Context, scope, tenantId string) (*AccessToken, error) {
+	if c.authConfig == nil {
+		return nil, fmt.Errorf("the Provider has not been configured with credentials")
+	}
+
+	if _, errs := validate.AccessTokenScope(scope, "scope"); len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	config := *c.authConfig
+	if tenantId != "" && !strings.EqualFold(tenantId, config.TenantID) {
+		if !slices.ContainsFunc(config.AuxiliaryTenantIDs, func(v string) bool { return strings.EqualFold(v, tenantId) }) {
+			return nil, fmt.Errorf("the tenant %q must be either the tenant the Provider is configured with or one of the `auxiliary_tenant_ids`", tenantId)
+		}
+		config.TenantID = tenantId
+	}
+	config.AuxiliaryTenantIDs = nil
+
+	resource := strings.TrimSuffix(strings.TrimSpace(scope), "/.default")
+	api := environments.NewApiEndpoint("AccessToken", resource, nil).WithResourceIdentifier(resource)
+
+	authorizer, err := auth.NewAuthorizerFromCredentials(ctx, config, api)
+	if err != nil {
+		return nil, fmt.Errorf("building authorizer for %q: %+v", scope, err)
+	}
+
+	token, err := authorizer.Token(ctx, &http.Request{})
+	if err != nil {
+		return nil, fmt.Errorf("obtaining access token for %q: %+v", scope, err)
+	}
+
+	return &AccessToken{
+		Token:     token.AccessToken,
+		ExpiresOn: token.Expiry,
+	}, nil
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// AccessTokenScope validates that the scope is either a resource identifier (e.g. `https://database.windows.net`) or
+// the `/.default` scope of a resource. Access Tokens are always requested for the `/.default` scope of the resource,
+// so other scopes such as `api://example/user.read` can't be supported.
+func AccessTokenScope(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !accessTokenScopeIsValid(strings.TrimSpace(v)) {
+		errors = append(errors, fmt.Errorf("%q must be either a resource identifier such as `https://database.windows.net` or a `/.default` scope such as `https://database.windows.net/.default`, got %q", k, v))
+	}
+
+	return
+}
+
+func accessTokenScopeIsValid(input string) bool {
+	if resource, ok := strings.CutSuffix(input, "/.default"); ok {
+		return resource != ""
+	}
+
+	if input == "" {
+		return false
+	}
+
+	if !strings.Contains(input, "://") {
+		// an Application ID, which can't contain a path
+		return !strings.Contains(input, "/")
+	}
+
+	u, err := url.Parse(input)
+	if err != nil || u.Host == "" {
+		return false
+	}
+
+	// anything after the host is a scope of the resource, rather than the resource itself
+	return u.Path == "" || u.Path == "/"
+}
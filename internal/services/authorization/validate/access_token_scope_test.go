@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestAccessTokenScope(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "/.default",
+			Valid: false,
+		},
+		{
+			Input: "https://management.azure.com/.default",
+			Valid: true,
+		},
+		{
+			Input: "https://database.windows.net",
+			Valid: true,
+		},
+		{
+			Input: "https://management.core.windows.net/",
+			Valid: true,
+		},
+		{
+			Input: "00000003-0000-0000-c000-000000000000",
+			Valid: true,
+		},
+		{
+			Input: "api://example/.default",
+			Valid: true,
+		},
+		{
+			Input: "api://example/user.read",
+			Valid: false,
+		},
+		{
+			Input: "https://graph.microsoft.com/User.Read",
+			Valid: false,
+		},
+		{
+			Input: "example/user.read",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := AccessTokenScope(tc.Input, "scope")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
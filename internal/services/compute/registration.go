@@ -110,6 +110,9 @@ func (r Registration) Resources() []sdk.Resource {
 func (r Registration) Actions() []func() action.Action {
 	return []func() action.Action{
 		newVirtualMachinePowerAction,
+		newVirtualMachineScaleSetAutomaticRepairsAction,
+		newVirtualMachineScaleSetInstancesAction,
+		newVirtualMachineScaleSetRollingUpgradeAction,
 	}
 }
 
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type VirtualMachineScaleSetAutomaticRepairsAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &VirtualMachineScaleSetAutomaticRepairsAction{}
+
+func newVirtualMachineScaleSetAutomaticRepairsAction() action.Action {
+	return &VirtualMachineScaleSetAutomaticRepairsAction{}
+}
+
+type VirtualMachineScaleSetAutomaticRepairsActionModel struct {
+	VirtualMachineScaleSetId types.String `tfsdk:"virtual_machine_scale_set_id"`
+	Operation                types.String `tfsdk:"operation"`
+	Timeout                  types.String `tfsdk:"timeout"`
+}
+
+func (v *VirtualMachineScaleSetAutomaticRepairsAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"virtual_machine_scale_set_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the virtual machine scale set on which to perform the action.",
+				MarkdownDescription: "The ID of the virtual machine scale set on which to perform the action.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: virtualmachinescalesets.ValidateVirtualMachineScaleSetID,
+					},
+				},
+			},
+
+			"operation": schema.StringAttribute{
+				Required:            true,
+				Description:         "The state change to apply to the automatic instance repairs of this virtual machine scale set. Possible values include `resume` and `suspend`.",
+				MarkdownDescription: "The state change to apply to the automatic instance repairs of this virtual machine scale set. Possible values include `resume` and `suspend`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"resume",
+						"suspend",
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (v *VirtualMachineScaleSetAutomaticRepairsAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_virtual_machine_scale_set_automatic_repairs"
+}
+
+func (v *VirtualMachineScaleSetAutomaticRepairsAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := v.Client.Compute.VirtualMachineScaleSetsClient
+
+	model := VirtualMachineScaleSetAutomaticRepairsActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := virtualmachinescalesets.ParseVirtualMachineScaleSetID(model.VirtualMachineScaleSetId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	operation := model.Operation.ValueString()
+
+	input := virtualmachinescalesets.OrchestrationServiceStateInput{
+		Action:      virtualmachinescalesets.OrchestrationServiceStateActionResume,
+		ServiceName: virtualmachinescalesets.OrchestrationServiceNamesAutomaticRepairs,
+	}
+	if operation == "suspend" {
+		input.Action = virtualmachinescalesets.OrchestrationServiceStateActionSuspend
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking %s of automatic repairs on %s", operation, id.VirtualMachineScaleSetName),
+	})
+
+	if err := client.SetOrchestrationServiceStateThenPoll(ctx, *id, input); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("setting the automatic repairs state of %s to %s: %+v", id, input.Action, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("action %s of automatic repairs on %s completed", operation, id.VirtualMachineScaleSetName),
+	})
+}
+
+func (v *VirtualMachineScaleSetAutomaticRepairsAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	v.Defaults(ctx, request, response)
+}
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/convert"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type VirtualMachineScaleSetInstancesAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &VirtualMachineScaleSetInstancesAction{}
+
+func newVirtualMachineScaleSetInstancesAction() action.Action {
+	return &VirtualMachineScaleSetInstancesAction{}
+}
+
+type VirtualMachineScaleSetInstancesActionModel struct {
+	VirtualMachineScaleSetId types.String                          `tfsdk:"virtual_machine_scale_set_id"`
+	Operation                types.String                          `tfsdk:"operation"`
+	InstanceIds              typehelpers.ListValueOf[types.String] `tfsdk:"instance_ids"`
+	Timeout                  types.String                          `tfsdk:"timeout"`
+}
+
+func (v *VirtualMachineScaleSetInstancesAction) Schema(ctx context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"virtual_machine_scale_set_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the virtual machine scale set on which to perform the action.",
+				MarkdownDescription: "The ID of the virtual machine scale set on which to perform the action.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: virtualmachinescalesets.ValidateVirtualMachineScaleSetID,
+					},
+				},
+			},
+
+			"operation": schema.StringAttribute{
+				Required:            true,
+				Description:         "The operation to perform on the instances of this virtual machine scale set. Possible values include `reimage`, `redeploy`, and `upgrade`.",
+				MarkdownDescription: "The operation to perform on the instances of this virtual machine scale set. Possible values include `reimage`, `redeploy`, and `upgrade`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"redeploy",
+						"reimage",
+						"upgrade",
+					),
+				},
+			},
+
+			"instance_ids": schema.ListAttribute{
+				CustomType:          typehelpers.NewListTypeOf[types.String](ctx),
+				ElementType:         types.StringType,
+				Required:            true,
+				Description:         "The instance IDs of the virtual machines on which to perform the operation.",
+				MarkdownDescription: "The instance IDs of the virtual machines on which to perform the operation.",
+				Validators: []validator.List{
+					listvalidator.All(
+						listvalidator.SizeAtLeast(1),
+						listvalidator.NoNullValues(),
+						listvalidator.UniqueValues(),
+						listvalidator.ValueStringsAre(
+							stringvalidator.LengthAtLeast(1),
+						),
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (v *VirtualMachineScaleSetInstancesAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_virtual_machine_scale_set_instances"
+}
+
+func (v *VirtualMachineScaleSetInstancesAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := v.Client.Compute.VirtualMachineScaleSetsClient
+
+	model := VirtualMachineScaleSetInstancesActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := virtualmachinescalesets.ParseVirtualMachineScaleSetID(model.VirtualMachineScaleSetId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	instanceIds := make([]string, 0)
+	convert.Expand(ctx, model.InstanceIds, &instanceIds, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	operation := model.Operation.ValueString()
+	target := fmt.Sprintf("instances [%s]", strings.Join(instanceIds, ", "))
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking %s on %s of %s", operation, target, id.VirtualMachineScaleSetName),
+	})
+
+	switch operation {
+	case "reimage":
+		input := virtualmachinescalesets.VirtualMachineScaleSetReimageParameters{
+			InstanceIds: pointer.To(instanceIds),
+		}
+		if err := client.ReimageThenPoll(ctx, *id, input); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("reimaging %s of %s: %+v", target, id, err))
+			return
+		}
+
+	case "redeploy":
+		input := virtualmachinescalesets.VirtualMachineScaleSetVMInstanceIDs{
+			InstanceIds: pointer.To(instanceIds),
+		}
+		if err := client.RedeployThenPoll(ctx, *id, input); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("redeploying %s of %s: %+v", target, id, err))
+			return
+		}
+
+	case "upgrade":
+		input := virtualmachinescalesets.VirtualMachineScaleSetVMInstanceRequiredIDs{
+			InstanceIds: instanceIds,
+		}
+		if err := client.UpdateInstancesThenPoll(ctx, *id, input); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("upgrading %s of %s to the latest model: %+v", target, id, err))
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("action %s on %s of %s completed", operation, target, id.VirtualMachineScaleSetName),
+	})
+}
+
+func (v *VirtualMachineScaleSetInstancesAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	v.Defaults(ctx, request, response)
+}
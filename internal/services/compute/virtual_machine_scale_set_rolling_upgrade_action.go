@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetrollingupgrades"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// virtualMachineScaleSetRollingUpgradeProgressInterval is how often the status of a running Rolling Upgrade is reported
+const virtualMachineScaleSetRollingUpgradeProgressInterval = 30 * time.Second
+
+type VirtualMachineScaleSetRollingUpgradeAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &VirtualMachineScaleSetRollingUpgradeAction{}
+
+func newVirtualMachineScaleSetRollingUpgradeAction() action.Action {
+	return &VirtualMachineScaleSetRollingUpgradeAction{}
+}
+
+type VirtualMachineScaleSetRollingUpgradeActionModel struct {
+	VirtualMachineScaleSetId types.String `tfsdk:"virtual_machine_scale_set_id"`
+	Operation                types.String `tfsdk:"operation"`
+	Timeout                  types.String `tfsdk:"timeout"`
+}
+
+func (v *VirtualMachineScaleSetRollingUpgradeAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"virtual_machine_scale_set_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the virtual machine scale set on which to perform the action.",
+				MarkdownDescription: "The ID of the virtual machine scale set on which to perform the action.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: virtualmachinescalesetrollingupgrades.ValidateVirtualMachineScaleSetID,
+					},
+				},
+			},
+
+			"operation": schema.StringAttribute{
+				Required:            true,
+				Description:         "The rolling upgrade operation to perform on this virtual machine scale set. Possible values include `start_os_upgrade`, `start_extension_upgrade`, and `cancel`.",
+				MarkdownDescription: "The rolling upgrade operation to perform on this virtual machine scale set. Possible values include `start_os_upgrade`, `start_extension_upgrade`, and `cancel`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"cancel",
+						"start_extension_upgrade",
+						"start_os_upgrade",
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (v *VirtualMachineScaleSetRollingUpgradeAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_virtual_machine_scale_set_rolling_upgrade"
+}
+
+func (v *VirtualMachineScaleSetRollingUpgradeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := v.Client.Compute.VirtualMachineScaleSetRollingUpgradesClient
+
+	model := VirtualMachineScaleSetRollingUpgradeActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := virtualmachinescalesetrollingupgrades.ParseVirtualMachineScaleSetID(model.VirtualMachineScaleSetId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	operation := model.Operation.ValueString()
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking %s on %s", operation, id.VirtualMachineScaleSetName),
+	})
+
+	switch operation {
+	case "cancel":
+		if err := client.CancelThenPoll(ctx, *id); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("cancelling the rolling upgrade for %s: %+v", id, err))
+			return
+		}
+
+	case "start_os_upgrade":
+		resp, err := client.StartOSUpgrade(ctx, *id)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting a rolling OS upgrade for %s: %+v", id, err))
+			return
+		}
+
+		if err := waitForVirtualMachineScaleSetRollingUpgrade(ctx, client, *id, resp.Poller, response); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("waiting for the rolling OS upgrade for %s: %+v", id, err))
+			return
+		}
+
+	case "start_extension_upgrade":
+		resp, err := client.StartExtensionUpgrade(ctx, *id)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting a rolling extension upgrade for %s: %+v", id, err))
+			return
+		}
+
+		if err := waitForVirtualMachineScaleSetRollingUpgrade(ctx, client, *id, resp.Poller, response); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("waiting for the rolling extension upgrade for %s: %+v", id, err))
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("action %s on %s completed", operation, id.VirtualMachineScaleSetName),
+	})
+}
+
+func (v *VirtualMachineScaleSetRollingUpgradeAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	v.Defaults(ctx, request, response)
+}
+
+// waitForVirtualMachineScaleSetRollingUpgrade polls the Rolling Upgrade until it's done, reporting the number of
+// instances in each state at regular intervals so that long-running upgrades don't appear to have stalled
+func waitForVirtualMachineScaleSetRollingUpgrade(ctx context.Context, client *virtualmachinescalesetrollingupgrades.VirtualMachineScaleSetRollingUpgradesClient, id virtualmachinescalesetrollingupgrades.VirtualMachineScaleSetId, poller pollers.Poller, response *action.InvokeResponse) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- poller.PollUntilDone(ctx)
+	}()
+
+	ticker := time.NewTicker(virtualMachineScaleSetRollingUpgradeProgressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-done:
+			return err
+
+		case <-ticker.C:
+			latest, err := client.GetLatest(ctx, id)
+			if err != nil || latest.Model == nil || latest.Model.Properties == nil {
+				// the status is informational only, the poller determines the outcome
+				continue
+			}
+
+			props := latest.Model.Properties
+			status := "Unknown"
+			if props.RunningStatus != nil && props.RunningStatus.Code != nil {
+				status = string(*props.RunningStatus.Code)
+			}
+
+			message := fmt.Sprintf("rolling upgrade on %s is %s", id.VirtualMachineScaleSetName, status)
+			if p := props.Progress; p != nil {
+				message += fmt.Sprintf(" (successful: %d, in progress: %d, pending: %d, failed: %d)",
+					pointer.From(p.SuccessfulInstanceCount), pointer.From(p.InProgressInstanceCount), pointer.From(p.PendingInstanceCount), pointer.From(p.FailedInstanceCount))
+			}
+
+			response.SendProgress(action.InvokeProgressEvent{
+				Message: message,
+			})
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/proximityplacementgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -76,19 +77,51 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 				RequiredWith: []string{
 					"admin_username",
 				},
+				AtLeastOneOf: []string{
+					"admin_password",
+					"admin_password_wo",
+					"os_managed_disk_id",
+				},
 				ConflictsWith: []string{
+					"admin_password_wo",
 					"os_managed_disk_id",
 				},
 				ValidateFunc: computeValidate.WindowsAdminPassword,
 			},
 
-			"admin_username": {
-				Type:     pluginsdk.TypeString,
+			"admin_password_wo": {
+				Type:      pluginsdk.TypeString,
+				Optional:  true,
+				WriteOnly: true,
+				RequiredWith: []string{
+					"admin_username",
+					"admin_password_wo_version",
+				},
+				AtLeastOneOf: []string{
+					"admin_password",
+					"admin_password_wo",
+					"os_managed_disk_id",
+				},
+				ConflictsWith: []string{
+					"admin_password",
+					"os_managed_disk_id",
+				},
+				ValidateFunc: computeValidate.WindowsAdminPassword,
+			},
+
+			"admin_password_wo_version": {
+				Type:     pluginsdk.TypeInt,
 				Optional: true,
 				ForceNew: true,
 				RequiredWith: []string{
-					"admin_password",
+					"admin_password_wo",
 				},
+			},
+
+			"admin_username": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
 				ExactlyOneOf: []string{
 					"admin_username",
 					"os_managed_disk_id",
@@ -669,8 +702,17 @@ func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interfa
 			}
 		}
 
+		adminPassword := d.Get("admin_password").(string)
+		woAdminPassword, err := pluginsdk.GetWriteOnly(d, "admin_password_wo", cty.String)
+		if err != nil {
+			return err
+		}
+		if !woAdminPassword.IsNull() {
+			adminPassword = woAdminPassword.AsString()
+		}
+
 		params.Properties.OsProfile = &virtualmachines.OSProfile{
-			AdminPassword:            pointer.To(d.Get("admin_password").(string)),
+			AdminPassword:            pointer.To(adminPassword),
 			AdminUsername:            pointer.To(d.Get("admin_username").(string)),
 			ComputerName:             pointer.To(computerName),
 			AllowExtensionOperations: pointer.To(allowExtensionOperations),
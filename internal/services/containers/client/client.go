@@ -23,6 +23,8 @@ import (
 	managedclusters20260401Client "github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2026-04-01/managedclusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2024-11-01/extensions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2025-04-01/fluxconfiguration"
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -48,6 +50,9 @@ type Client struct {
 	SnapshotClient                              *snapshots.SnapshotsClient
 	TrustedAccessClient                         *trustedaccess.TrustedAccessClient
 	Environment                                 environments.Environment
+
+	configureRegistryClient   func(c client.BaseClient)
+	resourceManagerAuthorizer auth.Authorizer
 }
 
 func NewContainersClient(o *common.ClientOptions) (*Client, error) {
@@ -175,5 +180,8 @@ func NewContainersClient(o *common.ClientOptions) (*Client, error) {
 		SnapshotClient:                              snapshotClient,
 		TrustedAccessClient:                         trustedAccessClient,
 		Environment:                                 o.Environment,
+
+		configureRegistryClient:   o.ConfigureWithoutLogging,
+		resourceManagerAuthorizer: o.Authorizers.ResourceManager,
 	}, nil
 }
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// ContainerRegistryRefreshToken is a refresh token issued by a Container Registry in exchange for an Entra ID access token,
+// which can be used as the password when authenticating to the registry with the username `00000000-0000-0000-0000-000000000000`
+type ContainerRegistryRefreshToken struct {
+	RefreshToken string
+	ExpiresOn    time.Time
+}
+
+// ExchangeContainerRegistryRefreshToken exchanges the Resource Manager access token of the Provider for a refresh token
+// for the Container Registry with the specified login server
+// See: https://github.com/Azure/acr/blob/main/docs/AAD-OAuth.md
+func (c Client) ExchangeContainerRegistryRefreshToken(ctx context.Context, loginServer, tenantId string) (*ContainerRegistryRefreshToken, error) {
+	token, err := c.resourceManagerAuthorizer.Token(ctx, &http.Request{})
+	if err != nil {
+		return nil, fmt.Errorf("obtaining access token: %+v", err)
+	}
+
+	// the access token is sent in the request body and the refresh token is returned in the response body, so the
+	// client isn't given an authorizer or the middleware which logs these
+	registryClient := client.NewClient("https://"+loginServer, "containerregistry", "")
+	c.configureRegistryClient(registryClient)
+
+	return exchangeContainerRegistryRefreshToken(ctx, registryClient, loginServer, tenantId, token.AccessToken)
+}
+
+func exchangeContainerRegistryRefreshToken(ctx context.Context, registryClient *client.Client, loginServer, tenantId, accessToken string) (*ContainerRegistryRefreshToken, error) {
+	opts := client.RequestOptions{
+		ContentType: "application/x-www-form-urlencoded",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPost,
+		Path:       "/oauth2/exchange",
+	}
+
+	req, err := registryClient.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("building request: %+v", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "access_token")
+	form.Set("service", loginServer)
+	form.Set("tenant", tenantId)
+	form.Set("access_token", accessToken)
+	if err := req.Marshal([]byte(form.Encode())); err != nil {
+		return nil, fmt.Errorf("marshaling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		// the error for an unexpected status includes the response body, which isn't returned since it may contain a token
+		if resp != nil && resp.Response != nil {
+			return nil, fmt.Errorf("unexpected status %s", resp.Status)
+		}
+		return nil, fmt.Errorf("sending request: %+v", err)
+	}
+
+	var result struct {
+		RefreshToken string `json:"refresh_token"`
+	}
+	if err := resp.Unmarshal(&result); err != nil {
+		return nil, fmt.Errorf("unmarshaling response: %+v", err)
+	}
+	if result.RefreshToken == "" {
+		return nil, fmt.Errorf("`refresh_token` was empty")
+	}
+
+	return &ContainerRegistryRefreshToken{
+		RefreshToken: result.RefreshToken,
+		ExpiresOn:    parseTokenExpiry(result.RefreshToken),
+	}, nil
+}
+
+// parseTokenExpiry returns the time at which a JWT expires, or the zero time if this can't be determined. The token
+// is issued directly by the registry over TLS, so the signature isn't verified.
+func parseTokenExpiry(token string) time.Time {
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return time.Time{}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return time.Time{}
+	}
+
+	var claims struct {
+		Expiry int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Expiry == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(claims.Expiry, 0).UTC()
+}
@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+func TestExchangeContainerRegistryRefreshToken(t *testing.T) {
+	refreshToken := "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(`{"exp":1704067200}`)) + ".c2lnbmF0dXJl"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/oauth2/exchange" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("grant_type") != "access_token" || r.PostForm.Get("service") != "example.azurecr.io" || r.PostForm.Get("tenant") != "00000000-0000-0000-0000-000000000001" || r.PostForm.Get("access_token") != "aad-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"refresh_token":"leaked"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"refresh_token":"` + refreshToken + `"}`))
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		Name        string
+		AccessToken string
+		ExpectError bool
+	}{
+		{
+			Name:        "Valid",
+			AccessToken: "aad-token",
+		},
+		{
+			Name:        "Unauthorized",
+			AccessToken: "other-token",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		registryClient := client.NewClient(server.URL, "containerregistry", "")
+		registryClient.SetTransport(server.Client().Transport)
+
+		actual, err := exchangeContainerRegistryRefreshToken(context.Background(), registryClient, "example.azurecr.io", "00000000-0000-0000-0000-000000000001", tc.AccessToken)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			if strings.Contains(err.Error(), "leaked") {
+				t.Fatalf("expected the response body to be omitted from the error but got %+v", err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if actual.RefreshToken != refreshToken {
+			t.Fatalf("expected refresh token %q but got %q", refreshToken, actual.RefreshToken)
+		}
+		if expected := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC); !actual.ExpiresOn.Equal(expected) {
+			t.Fatalf("expected expiry %s but got %s", expected, actual.ExpiresOn)
+		}
+	}
+}
+
+func TestParseTokenExpiry(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected time.Time
+	}{
+		{
+			Input:    "a." + base64.RawURLEncoding.EncodeToString([]byte(`{"exp":1704067200}`)) + ".c",
+			Expected: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Input:    "a." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"example"}`)) + ".c",
+			Expected: time.Time{},
+		},
+		{
+			Input:    "not-a-jwt",
+			Expected: time.Time{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		if actual := parseTokenExpiry(tc.Input); !actual.Equal(tc.Expected) {
+			t.Fatalf("expected %s but got %s", tc.Expected, actual)
+		}
+	}
+}
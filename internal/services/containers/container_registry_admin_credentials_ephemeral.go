@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/registries"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.EphemeralResource = &ContainerRegistryAdminCredentialsEphemeralResource{}
+
+func NewContainerRegistryAdminCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &ContainerRegistryAdminCredentialsEphemeralResource{}
+}
+
+type ContainerRegistryAdminCredentialsEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type ContainerRegistryAdminCredentialsEphemeralResourceModel struct {
+	ContainerRegistryID types.String `tfsdk:"container_registry_id"`
+	LoginServer         types.String `tfsdk:"login_server"`
+	Username            types.String `tfsdk:"username"`
+	Password            types.String `tfsdk:"password"`
+	Password2           types.String `tfsdk:"password2"`
+}
+
+func (e *ContainerRegistryAdminCredentialsEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_container_registry_admin_credentials"
+}
+
+func (e *ContainerRegistryAdminCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *ContainerRegistryAdminCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"container_registry_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: registries.ValidateRegistryID,
+					},
+				},
+			},
+
+			"login_server": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"username": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"password2": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *ContainerRegistryAdminCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Containers.ContainerRegistryClient.Registries
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data ContainerRegistryAdminCredentialsEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := registries.ParseRegistryID(data.ContainerRegistryID.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s", id), err)
+		return
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s", id), "`properties` was nil")
+		return
+	}
+	props := existing.Model.Properties
+
+	if !pointer.From(props.AdminUserEnabled) {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving admin credentials for %s", id), "the admin user is not enabled for this Container Registry")
+		return
+	}
+
+	credentials, err := client.ListCredentials(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving admin credentials for %s", id), err)
+		return
+	}
+
+	data.LoginServer = types.StringValue(pointer.From(props.LoginServer))
+	data.Username = types.StringValue("")
+	data.Password = types.StringValue("")
+	data.Password2 = types.StringValue("")
+	if model := credentials.Model; model != nil {
+		data.Username = types.StringValue(pointer.From(model.Username))
+		for _, v := range pointer.From(model.Passwords) {
+			switch pointer.From(v.Name) {
+			case registries.PasswordNamePassword:
+				data.Password = types.StringValue(pointer.From(v.Value))
+			case registries.PasswordNamePasswordTwo:
+				data.Password2 = types.StringValue(pointer.From(v.Value))
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type ContainerRegistryAdminCredentialsEphemeral struct{}
+
+func TestAccEphemeralContainerRegistryAdminCredentials_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_container_registry_admin_credentials", "test")
+	r := ContainerRegistryAdminCredentialsEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("login_server"), knownvalue.StringRegexp(regexp.MustCompile(`^testacccr\d+\.azurecr\.io$`))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("username"), knownvalue.StringRegexp(regexp.MustCompile(`^testacccr\d+$`))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("password"), knownvalue.StringRegexp(regexp.MustCompile(".+"))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("password2"), knownvalue.StringRegexp(regexp.MustCompile(".+"))),
+				},
+			},
+		},
+	})
+}
+
+func (ContainerRegistryAdminCredentialsEphemeral) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_container_registry_admin_credentials" "test" {
+  container_registry_id = azurerm_container_registry.test.id
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_container_registry_admin_credentials.test
+}
+
+resource "echo" "test" {}
+`, ContainerRegistryTokenPasswordResource{}.template(data))
+}
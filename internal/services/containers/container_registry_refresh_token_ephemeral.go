@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/registries"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+// containerRegistryRefreshTokenUsername is the username which must be used when authenticating with a refresh token
+const containerRegistryRefreshTokenUsername = "00000000-0000-0000-0000-000000000000"
+
+var _ sdk.EphemeralResource = &ContainerRegistryRefreshTokenEphemeralResource{}
+
+func NewContainerRegistryRefreshTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &ContainerRegistryRefreshTokenEphemeralResource{}
+}
+
+type ContainerRegistryRefreshTokenEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type ContainerRegistryRefreshTokenEphemeralResourceModel struct {
+	ContainerRegistryID types.String `tfsdk:"container_registry_id"`
+	LoginServer         types.String `tfsdk:"login_server"`
+	Username            types.String `tfsdk:"username"`
+	RefreshToken        types.String `tfsdk:"refresh_token"`
+	ExpiresOn           types.String `tfsdk:"expires_on"`
+}
+
+func (e *ContainerRegistryRefreshTokenEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_container_registry_refresh_token"
+}
+
+func (e *ContainerRegistryRefreshTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *ContainerRegistryRefreshTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"container_registry_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: registries.ValidateRegistryID,
+					},
+				},
+			},
+
+			"login_server": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"username": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"refresh_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"expires_on": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ContainerRegistryRefreshTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Containers
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data ContainerRegistryRefreshTokenEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := registries.ParseRegistryID(data.ContainerRegistryID.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	existing, err := client.ContainerRegistryClient.Registries.Get(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s", id), err)
+		return
+	}
+	if existing.Model == nil || existing.Model.Properties == nil || existing.Model.Properties.LoginServer == nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s", id), "`properties.loginServer` was nil")
+		return
+	}
+	loginServer := pointer.From(existing.Model.Properties.LoginServer)
+
+	token, err := client.ExchangeContainerRegistryRefreshToken(ctx, loginServer, e.Client.Account.TenantId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("exchanging access token for a refresh token for %s", id), err)
+		return
+	}
+
+	data.LoginServer = types.StringValue(loginServer)
+	data.Username = types.StringValue(containerRegistryRefreshTokenUsername)
+	data.RefreshToken = types.StringValue(token.RefreshToken)
+	data.ExpiresOn = types.StringValue("")
+	if !token.ExpiresOn.IsZero() {
+		data.ExpiresOn = types.StringValue(token.ExpiresOn.Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
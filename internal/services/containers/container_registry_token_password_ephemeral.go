@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/registries"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/tokens"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+)
+
+var _ sdk.EphemeralResource = &ContainerRegistryTokenPasswordEphemeralResource{}
+
+func NewContainerRegistryTokenPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &ContainerRegistryTokenPasswordEphemeralResource{}
+}
+
+type ContainerRegistryTokenPasswordEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type ContainerRegistryTokenPasswordEphemeralResourceModel struct {
+	ContainerRegistryTokenID types.String `tfsdk:"container_registry_token_id"`
+	PasswordName             types.String `tfsdk:"password_name"`
+	Validity                 types.String `tfsdk:"validity"`
+	Username                 types.String `tfsdk:"username"`
+	Password                 types.String `tfsdk:"password"`
+	Expiry                   types.String `tfsdk:"expiry"`
+}
+
+func (e *ContainerRegistryTokenPasswordEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_container_registry_token_password"
+}
+
+func (e *ContainerRegistryTokenPasswordEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *ContainerRegistryTokenPasswordEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"container_registry_token_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: tokens.ValidateTokenID,
+					},
+				},
+			},
+
+			"password_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(registries.PossibleValuesForTokenPasswordName()...),
+				},
+			},
+
+			"validity": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.Duration,
+					},
+				},
+			},
+
+			"username": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"expiry": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *ContainerRegistryTokenPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Containers.ContainerRegistryClient.Registries
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*30)
+	defer cancel()
+
+	var data ContainerRegistryTokenPasswordEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	tokenId, err := tokens.ParseTokenID(data.ContainerRegistryTokenID.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	passwordName := registries.TokenPasswordNamePasswordOne
+	if v := data.PasswordName.ValueString(); v != "" {
+		passwordName = registries.TokenPasswordName(v)
+	}
+
+	validity := time.Hour
+	if v := data.Validity.ValueString(); v != "" {
+		// the value has already been validated by the schema
+		validity, _ = time.ParseDuration(v)
+	}
+	if validity <= 0 {
+		sdk.SetResponseErrorDiagnostic(resp, "invalid `validity`", "`validity` must be greater than zero")
+		return
+	}
+	expiry := time.Now().UTC().Truncate(time.Second).Add(validity)
+
+	registryId := registries.NewRegistryID(tokenId.SubscriptionId, tokenId.ResourceGroupName, tokenId.RegistryName)
+	param := registries.GenerateCredentialsParameters{
+		TokenId: pointer.To(tokenId.ID()),
+		Name:    pointer.To(passwordName),
+	}
+	param.SetExpiryAsTime(expiry)
+
+	result, err := client.GenerateCredentials(ctx, registryId, param)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("generating %s for %s", passwordName, tokenId), err)
+		return
+	}
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("polling generation of %s for %s", passwordName, tokenId), err)
+		return
+	}
+
+	var credentials registries.GenerateCredentialsResult
+	if err := json.NewDecoder(result.HttpResponse.Body).Decode(&credentials); err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("decoding generated credentials for %s", tokenId), err)
+		return
+	}
+
+	password := ""
+	for _, v := range pointer.From(credentials.Passwords) {
+		if pointer.From(v.Name) == passwordName {
+			password = pointer.From(v.Value)
+			break
+		}
+	}
+	if password == "" {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("generating %s for %s", passwordName, tokenId), "the generated password was not returned")
+		return
+	}
+
+	data.PasswordName = types.StringValue(string(passwordName))
+	data.Validity = types.StringValue(validity.String())
+	data.Username = types.StringValue(pointer.From(credentials.Username))
+	data.Password = types.StringValue(password)
+	data.Expiry = types.StringValue(expiry.Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-10-01/agentpools"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type KubernetesClusterAbortOperationAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KubernetesClusterAbortOperationAction{}
+
+func newKubernetesClusterAbortOperationAction() action.Action {
+	return &KubernetesClusterAbortOperationAction{}
+}
+
+type KubernetesClusterAbortOperationActionModel struct {
+	KubernetesClusterId types.String `tfsdk:"kubernetes_cluster_id"`
+	NodePoolId          types.String `tfsdk:"kubernetes_cluster_node_pool_id"`
+	Timeout             types.String `tfsdk:"timeout"`
+}
+
+func (k *KubernetesClusterAbortOperationAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Kubernetes Cluster whose latest operation should be aborted.",
+				MarkdownDescription: "The ID of the Kubernetes Cluster whose latest operation should be aborted.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKubernetesClusterID,
+					},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("kubernetes_cluster_id"), path.MatchRoot("kubernetes_cluster_node_pool_id")),
+				},
+			},
+
+			"kubernetes_cluster_node_pool_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Kubernetes Cluster Node Pool whose latest operation should be aborted.",
+				MarkdownDescription: "The ID of the Kubernetes Cluster Node Pool whose latest operation should be aborted.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: agentpools.ValidateAgentPoolID,
+					},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("kubernetes_cluster_id"), path.MatchRoot("kubernetes_cluster_node_pool_id")),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (k *KubernetesClusterAbortOperationAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_kubernetes_cluster_abort_operation"
+}
+
+func (k *KubernetesClusterAbortOperationAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	model := KubernetesClusterAbortOperationActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	if v := model.NodePoolId.ValueString(); v != "" {
+		id, err := agentpools.ParseAgentPoolID(v)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("aborting the latest operation on %s", id),
+		})
+
+		if err := k.Client.Containers.AgentPoolsClient.AbortLatestOperationThenPoll(ctx, *id); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("aborting the latest operation on %s: %+v", id, err))
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("latest operation on %s aborted", id.AgentPoolName),
+		})
+		return
+	}
+
+	id, err := commonids.ParseKubernetesClusterID(model.KubernetesClusterId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("aborting the latest operation on %s", id),
+	})
+
+	if err := k.Client.Containers.KubernetesClustersClient.AbortLatestOperationThenPoll(ctx, *id); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("aborting the latest operation on %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("latest operation on %s aborted", id.ManagedClusterName),
+	})
+}
+
+func (k *KubernetesClusterAbortOperationAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-10-01/managedclusters"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/kubernetes"
+)
+
+const (
+	kubernetesClusterCredentialTypeAdmin      = "Admin"
+	kubernetesClusterCredentialTypeMonitoring = "Monitoring"
+	kubernetesClusterCredentialTypeUser       = "User"
+)
+
+var _ sdk.EphemeralResource = &KubernetesClusterCredentialsEphemeralResource{}
+
+func NewKubernetesClusterCredentialsEphemeralResource() ephemeral.EphemeralResource {
+	return &KubernetesClusterCredentialsEphemeralResource{}
+}
+
+type KubernetesClusterCredentialsEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type KubernetesClusterCredentialsEphemeralResourceModel struct {
+	KubernetesClusterID  types.String `tfsdk:"kubernetes_cluster_id"`
+	CredentialType       types.String `tfsdk:"credential_type"`
+	ServerFqdn           types.String `tfsdk:"server_fqdn"`
+	KubeConfigRaw        types.String `tfsdk:"kube_config_raw"`
+	Host                 types.String `tfsdk:"host"`
+	Username             types.String `tfsdk:"username"`
+	Password             types.String `tfsdk:"password"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+}
+
+func (e *KubernetesClusterCredentialsEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_kubernetes_cluster_credentials"
+}
+
+func (e *KubernetesClusterCredentialsEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *KubernetesClusterCredentialsEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKubernetesClusterID,
+					},
+				},
+			},
+
+			"credential_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						kubernetesClusterCredentialTypeAdmin,
+						kubernetesClusterCredentialTypeMonitoring,
+						kubernetesClusterCredentialTypeUser,
+					),
+				},
+			},
+
+			"server_fqdn": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"kube_config_raw": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"host": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"username": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"client_certificate": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"client_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *KubernetesClusterCredentialsEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Containers.KubernetesClustersClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data KubernetesClusterCredentialsEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := commonids.ParseKubernetesClusterID(data.KubernetesClusterID.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	credentialType := kubernetesClusterCredentialTypeUser
+	if v := data.CredentialType.ValueString(); v != "" {
+		credentialType = v
+	}
+
+	var serverFqdn *string
+	if v := data.ServerFqdn.ValueString(); v != "" {
+		serverFqdn = pointer.To(v)
+	}
+
+	var model *managedclusters.CredentialResults
+	switch credentialType {
+	case kubernetesClusterCredentialTypeAdmin:
+		result, err := client.ListClusterAdminCredentials(ctx, *id, managedclusters.ListClusterAdminCredentialsOperationOptions{ServerFqdn: serverFqdn})
+		if err != nil {
+			if response.WasNotFound(result.HttpResponse) {
+				sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("%s was not found", id), err)
+				return
+			}
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving Admin Credentials for %s", id), err)
+			return
+		}
+		model = result.Model
+
+	case kubernetesClusterCredentialTypeMonitoring:
+		result, err := client.ListClusterMonitoringUserCredentials(ctx, *id, managedclusters.ListClusterMonitoringUserCredentialsOperationOptions{ServerFqdn: serverFqdn})
+		if err != nil {
+			if response.WasNotFound(result.HttpResponse) {
+				sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("%s was not found", id), err)
+				return
+			}
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving Monitoring User Credentials for %s", id), err)
+			return
+		}
+		model = result.Model
+
+	default:
+		result, err := client.ListClusterUserCredentials(ctx, *id, managedclusters.ListClusterUserCredentialsOperationOptions{ServerFqdn: serverFqdn})
+		if err != nil {
+			if response.WasNotFound(result.HttpResponse) {
+				sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("%s was not found", id), err)
+				return
+			}
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving User Credentials for %s", id), err)
+			return
+		}
+		model = result.Model
+	}
+
+	if model == nil || model.Kubeconfigs == nil || len(*model.Kubeconfigs) == 0 {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s Credentials for %s", credentialType, id), "no kubeconfig was returned")
+		return
+	}
+
+	// the first kubeconfig is the one for the requested credential type
+	rawConfig := pointer.From((*model.Kubeconfigs)[0].Value)
+	if base64IsEncoded(rawConfig) {
+		rawConfig = base64Decode(rawConfig)
+	}
+
+	data.CredentialType = types.StringValue(credentialType)
+	data.KubeConfigRaw = types.StringValue(rawConfig)
+
+	// the errors returned when parsing the kubeconfig contain its content, so they're intentionally not surfaced
+	if strings.Contains(rawConfig, "apiserver-id:") || strings.Contains(rawConfig, "exec") {
+		kubeConfig, err := kubernetes.ParseKubeConfigAAD(rawConfig)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("parsing %s Credentials for %s", credentialType, id), "the kubeconfig returned from the API is not valid")
+			return
+		}
+
+		// clusters using Entra ID authenticate through kubelogin, so only the cluster details are available
+		data.Host = types.StringValue(kubeConfig.Clusters[0].Cluster.Server)
+		data.Username = types.StringValue(kubeConfig.Users[0].Name)
+		data.Password = types.StringValue("")
+		data.ClientCertificate = types.StringValue("")
+		data.ClientKey = types.StringValue("")
+		data.ClusterCACertificate = types.StringValue(kubeConfig.Clusters[0].Cluster.ClusterAuthorityData)
+	} else {
+		kubeConfig, err := kubernetes.ParseKubeConfig(rawConfig)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("parsing %s Credentials for %s", credentialType, id), "the kubeconfig returned from the API is not valid")
+			return
+		}
+
+		data.Host = types.StringValue(kubeConfig.Clusters[0].Cluster.Server)
+		data.Username = types.StringValue(kubeConfig.Users[0].Name)
+		data.Password = types.StringValue(kubeConfig.Users[0].User.Token)
+		data.ClientCertificate = types.StringValue(kubeConfig.Users[0].User.ClientCertificteData)
+		data.ClientKey = types.StringValue(kubeConfig.Users[0].User.ClientKeyData)
+		data.ClusterCACertificate = types.StringValue(kubeConfig.Clusters[0].Cluster.ClusterAuthorityData)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KubernetesClusterCredentialsEphemeral struct{}
+
+func TestAccEphemeralKubernetesClusterCredentials_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_kubernetes_cluster_credentials", "test")
+	r := KubernetesClusterCredentialsEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("credential_type"), knownvalue.StringExact("User")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("host"), knownvalue.StringRegexp(regexp.MustCompile("^https://"))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("client_certificate"), knownvalue.StringRegexp(regexp.MustCompile(".+"))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("client_key"), knownvalue.StringRegexp(regexp.MustCompile(".+"))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("cluster_ca_certificate"), knownvalue.StringRegexp(regexp.MustCompile(".+"))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccEphemeralKubernetesClusterCredentials_admin(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_kubernetes_cluster_credentials", "test")
+	r := KubernetesClusterCredentialsEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.admin(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("credential_type"), knownvalue.StringExact("Admin")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("host"), knownvalue.StringRegexp(regexp.MustCompile("^https://"))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("username"), knownvalue.StringRegexp(regexp.MustCompile("^clusterAdmin_"))),
+				},
+			},
+		},
+	})
+}
+
+func (KubernetesClusterCredentialsEphemeral) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_kubernetes_cluster_credentials" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_kubernetes_cluster_credentials.test
+}
+
+resource "echo" "test" {}
+`, KubernetesClusterResource{}.basic(data))
+}
+
+func (KubernetesClusterCredentialsEphemeral) admin(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_kubernetes_cluster_credentials" "test" {
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  credential_type       = "Admin"
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_kubernetes_cluster_credentials.test
+}
+
+resource "echo" "test" {}
+`, KubernetesClusterResource{}.basic(data))
+}
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-10-01/agentpools"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type KubernetesClusterNodePoolNodeImageUpgradeAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KubernetesClusterNodePoolNodeImageUpgradeAction{}
+
+func newKubernetesClusterNodePoolNodeImageUpgradeAction() action.Action {
+	return &KubernetesClusterNodePoolNodeImageUpgradeAction{}
+}
+
+type KubernetesClusterNodePoolNodeImageUpgradeActionModel struct {
+	NodePoolId types.String `tfsdk:"kubernetes_cluster_node_pool_id"`
+	Timeout    types.String `tfsdk:"timeout"`
+}
+
+func (k *KubernetesClusterNodePoolNodeImageUpgradeAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_node_pool_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Kubernetes Cluster Node Pool whose Node Image should be upgraded.",
+				MarkdownDescription: "The ID of the Kubernetes Cluster Node Pool whose Node Image should be upgraded.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: agentpools.ValidateAgentPoolID,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (k *KubernetesClusterNodePoolNodeImageUpgradeAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_kubernetes_cluster_node_pool_node_image_upgrade"
+}
+
+func (k *KubernetesClusterNodePoolNodeImageUpgradeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := k.Client.Containers.AgentPoolsClient
+
+	model := KubernetesClusterNodePoolNodeImageUpgradeActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := agentpools.ParseAgentPoolID(model.NodePoolId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("upgrading the node image of %s", id),
+	})
+
+	if err := client.UpgradeNodeImageVersionThenPoll(ctx, *id); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("upgrading the node image of %s: %+v", id, err))
+		return
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err == nil && existing.Model != nil && existing.Model.Properties != nil && existing.Model.Properties.NodeImageVersion != nil {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("node image of %s upgraded to %s", id.AgentPoolName, *existing.Model.Properties.NodeImageVersion),
+		})
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("node image upgrade of %s completed", id.AgentPoolName),
+	})
+}
+
+func (k *KubernetesClusterNodePoolNodeImageUpgradeAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
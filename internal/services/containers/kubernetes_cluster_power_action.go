@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type KubernetesClusterPowerAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KubernetesClusterPowerAction{}
+
+func newKubernetesClusterPowerAction() action.Action {
+	return &KubernetesClusterPowerAction{}
+}
+
+type KubernetesClusterPowerActionModel struct {
+	KubernetesClusterId types.String `tfsdk:"kubernetes_cluster_id"`
+	Action              types.String `tfsdk:"power_action"`
+	Timeout             types.String `tfsdk:"timeout"`
+}
+
+func (k *KubernetesClusterPowerAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Kubernetes Cluster on which to perform the action.",
+				MarkdownDescription: "The ID of the Kubernetes Cluster on which to perform the action.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKubernetesClusterID,
+					},
+				},
+			},
+
+			"power_action": schema.StringAttribute{
+				Required:            true,
+				Description:         "The power state action to take on this Kubernetes Cluster. Possible values are `start` and `stop`.",
+				MarkdownDescription: "The power state action to take on this Kubernetes Cluster. Possible values are `start` and `stop`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"start",
+						"stop",
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (k *KubernetesClusterPowerAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_kubernetes_cluster_power"
+}
+
+func (k *KubernetesClusterPowerAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := k.Client.Containers.KubernetesClustersClient
+
+	model := KubernetesClusterPowerActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := commonids.ParseKubernetesClusterID(model.KubernetesClusterId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	powerAction := model.Action.ValueString()
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking %s on %s", powerAction, id.ManagedClusterName),
+	})
+
+	switch powerAction {
+	case "start":
+		if err := client.StartThenPoll(ctx, *id); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting %s: %+v", id, err))
+			return
+		}
+
+	case "stop":
+		if err := client.StopThenPoll(ctx, *id); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("stopping %s: %+v", id, err))
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("action %s on %s completed", powerAction, id.ManagedClusterName),
+	})
+}
+
+func (k *KubernetesClusterPowerAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KubernetesClusterPowerAction struct{}
+
+func TestAccKubernetesClusterPowerAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_power", "test")
+	a := KubernetesClusterPowerAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func TestAccKubernetesClusterPowerAction_stopAndStart(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_power", "test")
+	a := KubernetesClusterPowerAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.stopAndStart(data, "create"),
+			},
+			{
+				Config: a.stopAndStart(data, "update"),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *KubernetesClusterPowerAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_kubernetes_cluster.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_kubernetes_cluster_power.stop]
+    }
+  }
+}
+
+action "azurerm_kubernetes_cluster_power" "stop" {
+  config {
+    kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+    power_action          = "stop"
+  }
+}
+`, KubernetesClusterResource{}.basicVMSSConfig(data))
+}
+
+func (a *KubernetesClusterPowerAction) stopAndStart(data acceptance.TestData, tagVal string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = "%s"
+
+  lifecycle {
+    action_trigger {
+      events  = [before_update]
+      actions = [action.azurerm_kubernetes_cluster_power.stop]
+    }
+
+    action_trigger {
+      events  = [after_update]
+      actions = [action.azurerm_kubernetes_cluster_power.start]
+    }
+  }
+}
+
+action "azurerm_kubernetes_cluster_power" "stop" {
+  config {
+    kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+    power_action          = "stop"
+  }
+}
+
+action "azurerm_kubernetes_cluster_power" "start" {
+  config {
+    kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+    power_action          = "start"
+    timeout               = "90m"
+  }
+}
+`, KubernetesClusterResource{}.basicVMSSConfig(data), tagVal)
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type KubernetesClusterRotateCertificatesAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KubernetesClusterRotateCertificatesAction{}
+
+func newKubernetesClusterRotateCertificatesAction() action.Action {
+	return &KubernetesClusterRotateCertificatesAction{}
+}
+
+type KubernetesClusterRotateCertificatesActionModel struct {
+	KubernetesClusterId types.String `tfsdk:"kubernetes_cluster_id"`
+	Timeout             types.String `tfsdk:"timeout"`
+}
+
+func (k *KubernetesClusterRotateCertificatesAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Kubernetes Cluster whose certificates should be rotated.",
+				MarkdownDescription: "The ID of the Kubernetes Cluster whose certificates should be rotated.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKubernetesClusterID,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `90m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `90m`.",
+			},
+		},
+	}
+}
+
+func (k *KubernetesClusterRotateCertificatesAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_kubernetes_cluster_rotate_certificates"
+}
+
+func (k *KubernetesClusterRotateCertificatesAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := k.Client.Containers.KubernetesClustersClient
+
+	model := KubernetesClusterRotateCertificatesActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// rotating the certificates recreates every node in the cluster, so this can take a while
+	ctxTimeout := 90 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := commonids.ParseKubernetesClusterID(model.KubernetesClusterId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("rotating the certificates of %s", id),
+	})
+
+	if err := client.RotateClusterCertificatesThenPoll(ctx, *id); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("rotating the certificates of %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("certificates of %s rotated", id.ManagedClusterName),
+	})
+}
+
+func (k *KubernetesClusterRotateCertificatesAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-10-01/managedclusters"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// kubernetesServiceAADServerScope is the scope of the Entra ID application used by the API Server of clusters with
+// Entra ID integration enabled, a token for which must be supplied to run a command against these clusters
+const kubernetesServiceAADServerScope = "6dae42f8-4368-4678-94ff-3960e28e3630/.default"
+
+type KubernetesClusterRunCommandAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KubernetesClusterRunCommandAction{}
+
+func newKubernetesClusterRunCommandAction() action.Action {
+	return &KubernetesClusterRunCommandAction{}
+}
+
+type KubernetesClusterRunCommandActionModel struct {
+	KubernetesClusterId types.String `tfsdk:"kubernetes_cluster_id"`
+	Command             types.String `tfsdk:"command"`
+	Context             types.String `tfsdk:"context"`
+	Timeout             types.String `tfsdk:"timeout"`
+}
+
+func (k *KubernetesClusterRunCommandAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Kubernetes Cluster on which to run the command.",
+				MarkdownDescription: "The ID of the Kubernetes Cluster on which to run the command.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKubernetesClusterID,
+					},
+				},
+			},
+
+			"command": schema.StringAttribute{
+				Required:            true,
+				Description:         "The command to run, for example `kubectl get pods -A`.",
+				MarkdownDescription: "The command to run, for example `kubectl get pods -A`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotWhiteSpace,
+					},
+				},
+			},
+
+			"context": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A base64 encoded zip file containing the files required by the command, such as manifests referenced by `kubectl apply -f`.",
+				MarkdownDescription: "A base64 encoded zip file containing the files required by the command, such as manifests referenced by `kubectl apply -f`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsBase64,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the command to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the command to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (k *KubernetesClusterRunCommandAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_kubernetes_cluster_run_command"
+}
+
+func (k *KubernetesClusterRunCommandAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := k.Client.Containers.KubernetesClustersClient
+
+	model := KubernetesClusterRunCommandActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := commonids.ParseKubernetesClusterID(model.KubernetesClusterId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("retrieving %s: %+v", id, err))
+		return
+	}
+
+	payload := managedclusters.RunCommandRequest{
+		Command: model.Command.ValueString(),
+	}
+	if v := model.Context.ValueString(); v != "" {
+		payload.Context = pointer.To(v)
+	}
+
+	// clusters with Entra ID integration require a token for the API Server to run the command as
+	if existing.Model != nil && existing.Model.Properties != nil && existing.Model.Properties.AadProfile != nil {
+		token, err := k.Client.Authorization.AccessToken(ctx, kubernetesServiceAADServerScope, k.Client.Account.TenantId)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("obtaining a cluster token for %s: %+v", id, err))
+			return
+		}
+		payload.ClusterToken = pointer.To(token.Token)
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("running command on %s", id.ManagedClusterName),
+	})
+
+	// Normally we would use `ThenPoll` but we need the last response for the result of the command
+	result, err := client.RunCommand(ctx, *id, payload)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("running command on %s: %+v", id, err))
+		return
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("waiting for command to complete on %s: %+v", id, err))
+		return
+	}
+
+	lastResponse := result.Poller.LatestResponse()
+	if lastResponse == nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("waiting for command to complete on %s: last response was nil", id))
+		return
+	}
+
+	var commandResult managedclusters.RunCommandResult
+	if err := lastResponse.Unmarshal(&commandResult); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("retrieving the result of the command on %s: %+v", id, err))
+		return
+	}
+
+	props := pointer.From(commandResult.Properties)
+	if logs := strings.TrimSpace(pointer.From(props.Logs)); logs != "" {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: logs,
+		})
+	}
+
+	if exitCode := pointer.From(props.ExitCode); exitCode != 0 {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("command on %s exited with code %d: %s", id, exitCode, pointer.From(props.Reason)))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("command on %s completed", id.ManagedClusterName),
+	})
+}
+
+func (k *KubernetesClusterRunCommandAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
@@ -92,7 +92,13 @@ func (r Registration) Resources() []sdk.Resource {
 }
 
 func (r Registration) Actions() []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		newKubernetesClusterAbortOperationAction,
+		newKubernetesClusterNodePoolNodeImageUpgradeAction,
+		newKubernetesClusterPowerAction,
+		newKubernetesClusterRotateCertificatesAction,
+		newKubernetesClusterRunCommandAction,
+	}
 }
 
 func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
@@ -104,7 +110,12 @@ func (r Registration) FrameworkDataSources() []sdk.FrameworkWrappedDataSource {
 }
 
 func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewContainerRegistryAdminCredentialsEphemeralResource,
+		NewContainerRegistryRefreshTokenEphemeralResource,
+		NewContainerRegistryTokenPasswordEphemeralResource,
+		NewKubernetesClusterCredentialsEphemeralResource,
+	}
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
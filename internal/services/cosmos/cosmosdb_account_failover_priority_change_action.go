@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/convert"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type CosmosDBAccountFailoverPriorityChangeAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &CosmosDBAccountFailoverPriorityChangeAction{}
+
+func newCosmosDBAccountFailoverPriorityChangeAction() action.Action {
+	return &CosmosDBAccountFailoverPriorityChangeAction{}
+}
+
+type CosmosDBAccountFailoverPriorityChangeActionModel struct {
+	CosmosDBAccountId types.String                          `tfsdk:"cosmosdb_account_id"`
+	Locations         typehelpers.ListValueOf[types.String] `tfsdk:"locations"`
+	Timeout           types.String                          `tfsdk:"timeout"`
+}
+
+func (c *CosmosDBAccountFailoverPriorityChangeAction) Schema(ctx context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cosmosdb_account_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Cosmos DB Account whose failover priorities should be changed.",
+				MarkdownDescription: "The ID of the Cosmos DB Account whose failover priorities should be changed.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: cosmosdb.ValidateDatabaseAccountID,
+					},
+				},
+			},
+
+			"locations": schema.ListAttribute{
+				CustomType:          typehelpers.NewListTypeOf[types.String](ctx),
+				ElementType:         types.StringType,
+				Required:            true,
+				Description:         "The Azure Regions of the Cosmos DB Account in order of failover priority. The first region becomes the write region. Every region of the account must be specified.",
+				MarkdownDescription: "The Azure Regions of the Cosmos DB Account in order of failover priority. The first region becomes the write region. Every region of the account must be specified.",
+				Validators: []validator.List{
+					listvalidator.All(
+						listvalidator.SizeAtLeast(1),
+						listvalidator.NoNullValues(),
+						listvalidator.UniqueValues(),
+						listvalidator.ValueStringsAre(
+							stringvalidator.LengthAtLeast(1),
+						),
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (c *CosmosDBAccountFailoverPriorityChangeAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_cosmosdb_account_failover_priority_change"
+}
+
+func (c *CosmosDBAccountFailoverPriorityChangeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := c.Client.Cosmos.CosmosDBClient
+
+	model := CosmosDBAccountFailoverPriorityChangeActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := cosmosdb.ParseDatabaseAccountID(model.CosmosDBAccountId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	locations := make([]string, 0)
+	convert.Expand(ctx, model.Locations, &locations, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	policies := make([]cosmosdb.FailoverPolicy, 0, len(locations))
+	for priority, loc := range locations {
+		policies = append(policies, cosmosdb.FailoverPolicy{
+			LocationName:     pointer.To(location.Normalize(loc)),
+			FailoverPriority: pointer.To(int64(priority)),
+		})
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("changing the failover priority of %s to [%s]", id.DatabaseAccountName, strings.Join(locations, ", ")),
+	})
+
+	input := cosmosdb.FailoverPolicies{
+		FailoverPolicies: policies,
+	}
+	if err := client.DatabaseAccountsFailoverPriorityChangeThenPoll(ctx, *id, input); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("changing the failover priority of %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%s is now the write region of %s", locations[0], id.DatabaseAccountName),
+	})
+}
+
+func (c *CosmosDBAccountFailoverPriorityChangeAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	c.Defaults(ctx, request, response)
+}
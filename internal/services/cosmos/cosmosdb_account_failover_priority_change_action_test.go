@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type CosmosDBAccountFailoverPriorityChangeAction struct{}
+
+func TestAccCosmosDBAccountFailoverPriorityChangeAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_account_failover_priority_change", "test")
+	a := CosmosDBAccountFailoverPriorityChangeAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *CosmosDBAccountFailoverPriorityChangeAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_cosmosdb_account.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_cosmosdb_account_failover_priority_change.test]
+    }
+  }
+}
+
+action "azurerm_cosmosdb_account_failover_priority_change" "test" {
+  config {
+    cosmosdb_account_id = azurerm_cosmosdb_account.test.id
+    locations           = ["%s", "%s"]
+  }
+}
+`, CosmosDBAccountResource{}.geoLocationUpdate(data, cosmosdb.DatabaseAccountKindGlobalDocumentDB, cosmosdb.DefaultConsistencyLevelEventual), data.Locations.Secondary, data.Locations.Primary)
+}
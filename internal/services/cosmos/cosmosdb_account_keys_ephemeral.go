@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.EphemeralResource = &CosmosDBAccountKeysEphemeralResource{}
+
+func NewCosmosDBAccountKeysEphemeralResource() ephemeral.EphemeralResource {
+	return &CosmosDBAccountKeysEphemeralResource{}
+}
+
+type CosmosDBAccountKeysEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type CosmosDBAccountKeysEphemeralResourceModel struct {
+	CosmosDBAccountID                        types.String `tfsdk:"cosmosdb_account_id"`
+	PrimaryKey                               types.String `tfsdk:"primary_key"`
+	SecondaryKey                             types.String `tfsdk:"secondary_key"`
+	PrimaryReadonlyKey                       types.String `tfsdk:"primary_readonly_key"`
+	SecondaryReadonlyKey                     types.String `tfsdk:"secondary_readonly_key"`
+	PrimarySqlConnectionString               types.String `tfsdk:"primary_sql_connection_string"`
+	SecondarySqlConnectionString             types.String `tfsdk:"secondary_sql_connection_string"`
+	PrimaryReadonlySqlConnectionString       types.String `tfsdk:"primary_readonly_sql_connection_string"`
+	SecondaryReadonlySqlConnectionString     types.String `tfsdk:"secondary_readonly_sql_connection_string"`
+	PrimaryMongoDBConnectionString           types.String `tfsdk:"primary_mongodb_connection_string"`
+	SecondaryMongoDBConnectionString         types.String `tfsdk:"secondary_mongodb_connection_string"`
+	PrimaryReadonlyMongoDBConnectionString   types.String `tfsdk:"primary_readonly_mongodb_connection_string"`
+	SecondaryReadonlyMongoDBConnectionString types.String `tfsdk:"secondary_readonly_mongodb_connection_string"`
+}
+
+func (e *CosmosDBAccountKeysEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_cosmosdb_account_keys"
+}
+
+func (e *CosmosDBAccountKeysEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *CosmosDBAccountKeysEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"cosmosdb_account_id": schema.StringAttribute{
+			Required: true,
+			Validators: []validator.String{
+				typehelpers.WrappedStringValidator{
+					Func: cosmosdb.ValidateDatabaseAccountID,
+				},
+			},
+		},
+	}
+
+	for _, name := range []string{"primary_key", "secondary_key", "primary_readonly_key", "secondary_readonly_key"} {
+		attributes[name] = schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		}
+	}
+
+	for _, name := range connStringPropertyMap {
+		attributes[name] = schema.StringAttribute{
+			Computed:  true,
+			Sensitive: true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+func (e *CosmosDBAccountKeysEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Cosmos.CosmosDBClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data CosmosDBAccountKeysEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := cosmosdb.ParseDatabaseAccountID(data.CosmosDBAccountID.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	keys, err := client.DatabaseAccountsListKeys(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing keys for %s", id), err)
+		return
+	}
+	if model := keys.Model; model != nil {
+		data.PrimaryKey = types.StringValue(pointer.From(model.PrimaryMasterKey))
+		data.SecondaryKey = types.StringValue(pointer.From(model.SecondaryMasterKey))
+		data.PrimaryReadonlyKey = types.StringValue(pointer.From(model.PrimaryReadonlyMasterKey))
+		data.SecondaryReadonlyKey = types.StringValue(pointer.From(model.SecondaryReadonlyMasterKey))
+	}
+
+	connectionStrings, err := client.DatabaseAccountsListConnectionStrings(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing connection strings for %s", id), err)
+		return
+	}
+
+	// the connection strings returned depend on the kind of the Account, those which aren't returned are empty
+	values := make(map[string]string)
+	if model := connectionStrings.Model; model != nil {
+		for _, v := range pointer.From(model.ConnectionStrings) {
+			if propertyName, ok := connStringPropertyMap[pointer.From(v.Description)]; ok {
+				values[propertyName] = pointer.From(v.ConnectionString)
+			}
+		}
+	}
+	data.PrimarySqlConnectionString = types.StringValue(values["primary_sql_connection_string"])
+	data.SecondarySqlConnectionString = types.StringValue(values["secondary_sql_connection_string"])
+	data.PrimaryReadonlySqlConnectionString = types.StringValue(values["primary_readonly_sql_connection_string"])
+	data.SecondaryReadonlySqlConnectionString = types.StringValue(values["secondary_readonly_sql_connection_string"])
+	data.PrimaryMongoDBConnectionString = types.StringValue(values["primary_mongodb_connection_string"])
+	data.SecondaryMongoDBConnectionString = types.StringValue(values["secondary_mongodb_connection_string"])
+	data.PrimaryReadonlyMongoDBConnectionString = types.StringValue(values["primary_readonly_mongodb_connection_string"])
+	data.SecondaryReadonlyMongoDBConnectionString = types.StringValue(values["secondary_readonly_mongodb_connection_string"])
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -177,9 +177,25 @@ func resourceDataFactoryLinkedServiceAzureBlobStorage() *pluginsdk.Resource {
 			},
 
 			"service_principal_key": {
-				Type:         pluginsdk.TypeString,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"service_principal_key_wo"},
+			},
+
+			"service_principal_key_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				RequiredWith:  []string{"service_principal_key_wo_version"},
+				ConflictsWith: []string{"service_principal_key", "service_principal_linked_key_vault_key"},
+			},
+
+			"service_principal_key_wo_version": {
+				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{"service_principal_key_wo"},
 			},
 
 			"tenant_id": {
@@ -334,8 +350,13 @@ func resourceDataFactoryLinkedServiceBlobStorageCreateUpdate(d *pluginsdk.Resour
 		if kvsp, ok := d.GetOk("service_principal_linked_key_vault_key"); ok {
 			blobStorageProperties.ServicePrincipalKey = expandAzureKeyVaultSecretReference(kvsp.([]interface{}))
 		} else {
+			servicePrincipalKey, err := expandLinkedServiceServicePrincipalKey(d)
+			if err != nil {
+				return err
+			}
+
 			secureString := datafactory.SecureString{
-				Value: pointer.To(d.Get("service_principal_key").(string)),
+				Value: pointer.To(servicePrincipalKey),
 				Type:  datafactory.TypeSecureString,
 			}
 			blobStorageProperties.ServicePrincipalKey = &secureString
@@ -10,10 +10,14 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -166,6 +170,59 @@ func TestAccDataFactoryLinkedServiceAzureBlobStorage_connectionStringInsecure(t
 	})
 }
 
+func TestAccDataFactoryLinkedServiceAzureBlobStorage_writeOnlyServicePrincipalKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_azure_blob_storage", "test")
+	r := LinkedServiceAzureBlobStorageResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.writeOnlyServicePrincipalKey(data, "first-key", 1),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_endpoint", "service_principal_key_wo_version"),
+			{
+				Config: r.writeOnlyServicePrincipalKey(data, "second-key", 2),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_endpoint", "service_principal_key_wo_version"),
+		},
+	})
+}
+
+func TestAccDataFactoryLinkedServiceAzureBlobStorage_updateToWriteOnlyServicePrincipalKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_azure_blob_storage", "test")
+	r := LinkedServiceAzureBlobStorageResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.servicePrincipal(data),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_endpoint", "service_principal_key"),
+			{
+				Config: r.writeOnlyServicePrincipalKey(data, "first-key", 1),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_endpoint", "service_principal_key", "service_principal_key_wo_version"),
+			{
+				Config: r.servicePrincipal(data),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_endpoint", "service_principal_key"),
+		},
+	})
+}
+
 func (t LinkedServiceAzureBlobStorageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.LinkedServiceID(state.ID)
 	if err != nil {
@@ -536,3 +593,62 @@ resource "azurerm_data_factory_linked_service_azure_blob_storage" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
+
+func (LinkedServiceAzureBlobStorageResource) servicePrincipal(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_linked_service_azure_blob_storage" "test" {
+  name                  = "acctestlsblob%[1]d"
+  data_factory_id       = azurerm_data_factory.test.id
+  service_endpoint      = "https://storageaccountname.blob.core.windows.net"
+  service_principal_id  = "00000000-0000-0000-0000-000000000000"
+  service_principal_key = "testkey"
+  tenant_id             = "11111111-1111-1111-1111-111111111111"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (LinkedServiceAzureBlobStorageResource) writeOnlyServicePrincipalKey(data acceptance.TestData, secret string, version int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+%[3]s
+
+resource "azurerm_data_factory_linked_service_azure_blob_storage" "test" {
+  name                             = "acctestlsblob%[1]d"
+  data_factory_id                  = azurerm_data_factory.test.id
+  service_endpoint                 = "https://storageaccountname.blob.core.windows.net"
+  service_principal_id             = "00000000-0000-0000-0000-000000000000"
+  service_principal_key_wo         = ephemeral.azurerm_key_vault_secret.test.value
+  service_principal_key_wo_version = %[4]d
+  tenant_id                        = "11111111-1111-1111-1111-111111111111"
+}
+`, data.RandomInteger, data.Locations.Primary, acceptance.WriteOnlyKeyVaultSecretTemplate(data, secret), version)
+}
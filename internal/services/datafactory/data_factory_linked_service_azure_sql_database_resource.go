@@ -39,6 +39,8 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabase() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(linkedServiceServicePrincipalKeyCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -124,17 +126,32 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabase() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.IsUUID,
-				RequiredWith: []string{"service_principal_key"},
 				ConflictsWith: []string{
 					"use_managed_identity",
 				},
 			},
 
 			"service_principal_key": {
-				Type:         pluginsdk.TypeString,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				RequiredWith:  []string{"service_principal_id"},
+				ConflictsWith: []string{"service_principal_key_wo"},
+			},
+
+			"service_principal_key_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				RequiredWith:  []string{"service_principal_id", "service_principal_key_wo_version"},
+				ConflictsWith: []string{"service_principal_key"},
+			},
+
+			"service_principal_key_wo_version": {
+				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
-				RequiredWith: []string{"service_principal_id"},
+				RequiredWith: []string{"service_principal_key_wo"},
 			},
 
 			"tenant_id": {
@@ -230,8 +247,13 @@ func resourceDataFactoryLinkedServiceAzureSQLDatabaseCreateUpdate(d *pluginsdk.R
 	if d.Get("use_managed_identity").(bool) {
 		sqlDatabaseProperties.Tenant = pointer.To(d.Get("tenant_id").(string))
 	} else {
+		servicePrincipalKey, err := expandLinkedServiceServicePrincipalKey(d)
+		if err != nil {
+			return err
+		}
+
 		secureString := datafactory.SecureString{
-			Value: pointer.To(d.Get("service_principal_key").(string)),
+			Value: pointer.To(servicePrincipalKey),
 			Type:  datafactory.TypeSecureString,
 		}
 
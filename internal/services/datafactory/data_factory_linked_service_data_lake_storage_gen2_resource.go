@@ -39,6 +39,8 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(linkedServiceServicePrincipalKeyCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -64,17 +66,17 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2() *pluginsdk.Resource {
 				Type:          pluginsdk.TypeBool,
 				Optional:      true,
 				Default:       false,
-				ConflictsWith: []string{"service_principal_key", "service_principal_id", "storage_account_key", "tenant"},
-				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
+				ConflictsWith: []string{"service_principal_key", "service_principal_key_wo", "service_principal_id", "storage_account_key", "tenant"},
+				AtLeastOneOf:  []string{"service_principal_key", "service_principal_key_wo", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
 			},
 
 			"service_principal_id": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
 				ValidateFunc:  validation.IsUUID,
-				RequiredWith:  []string{"service_principal_key", "tenant"},
+				RequiredWith:  []string{"tenant"},
 				ConflictsWith: []string{"storage_account_key", "use_managed_identity"},
-				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
+				AtLeastOneOf:  []string{"service_principal_key", "service_principal_key_wo", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
 			},
 
 			"service_principal_key": {
@@ -83,25 +85,41 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2() *pluginsdk.Resource {
 				Sensitive:     true,
 				ValidateFunc:  validation.StringIsNotEmpty,
 				RequiredWith:  []string{"service_principal_id", "tenant"},
-				ConflictsWith: []string{"storage_account_key", "use_managed_identity"},
-				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
+				ConflictsWith: []string{"service_principal_key_wo", "storage_account_key", "use_managed_identity"},
+				AtLeastOneOf:  []string{"service_principal_key", "service_principal_key_wo", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
+			},
+
+			"service_principal_key_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				RequiredWith:  []string{"service_principal_id", "service_principal_key_wo_version", "tenant"},
+				ConflictsWith: []string{"service_principal_key", "storage_account_key", "use_managed_identity"},
+				AtLeastOneOf:  []string{"service_principal_key", "service_principal_key_wo", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
+			},
+
+			"service_principal_key_wo_version": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"service_principal_key_wo"},
 			},
 
 			"storage_account_key": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"service_principal_id", "service_principal_key", "use_managed_identity", "tenant"},
-				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
+				ConflictsWith: []string{"service_principal_id", "service_principal_key", "service_principal_key_wo", "use_managed_identity", "tenant"},
+				AtLeastOneOf:  []string{"service_principal_key", "service_principal_key_wo", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
 			},
 
 			"tenant": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
 				ValidateFunc:  validation.StringIsNotEmpty,
-				RequiredWith:  []string{"service_principal_id", "service_principal_key"},
+				RequiredWith:  []string{"service_principal_id"},
 				ConflictsWith: []string{"storage_account_key", "use_managed_identity"},
-				AtLeastOneOf:  []string{"service_principal_key", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
+				AtLeastOneOf:  []string{"service_principal_key", "service_principal_key_wo", "service_principal_id", "tenant", "storage_account_key", "use_managed_identity"},
 			},
 
 			"description": {
@@ -186,8 +204,13 @@ func resourceDataFactoryLinkedServiceDataLakeStorageGen2CreateUpdate(d *pluginsd
 			},
 		}
 	} else {
+		servicePrincipalKey, err := expandLinkedServiceServicePrincipalKey(d)
+		if err != nil {
+			return err
+		}
+
 		secureString := datafactory.SecureString{
-			Value: pointer.To(d.Get("service_principal_key").(string)),
+			Value: pointer.To(servicePrincipalKey),
 			Type:  datafactory.TypeSecureString,
 		}
 
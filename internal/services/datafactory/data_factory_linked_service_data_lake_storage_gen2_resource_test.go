@@ -9,9 +9,13 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -92,6 +96,59 @@ func TestAccDataFactoryLinkedServiceDataLakeStorageGen2_update(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryLinkedServiceDataLakeStorageGen2_writeOnlyServicePrincipalKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_data_lake_storage_gen2", "test")
+	r := LinkedServiceDataLakeStorageGen2Resource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.writeOnlyServicePrincipalKey(data, "first-key", 1),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key_wo_version", "use_managed_identity"),
+			{
+				Config: r.writeOnlyServicePrincipalKey(data, "second-key", 2),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key_wo_version", "use_managed_identity"),
+		},
+	})
+}
+
+func TestAccDataFactoryLinkedServiceDataLakeStorageGen2_updateToWriteOnlyServicePrincipalKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_data_lake_storage_gen2", "test")
+	r := LinkedServiceDataLakeStorageGen2Resource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key", "use_managed_identity"),
+			{
+				Config: r.writeOnlyServicePrincipalKey(data, "first-key", 1),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key", "use_managed_identity", "service_principal_key_wo_version"),
+			{
+				Config: r.basic(data),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key", "use_managed_identity"),
+		},
+	})
+}
+
 func (t LinkedServiceDataLakeStorageGen2Resource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.LinkedServiceID(state.ID)
 	if err != nil {
@@ -290,3 +347,34 @@ resource "azurerm_data_factory_linked_service_data_lake_storage_gen2" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
+
+func (LinkedServiceDataLakeStorageGen2Resource) writeOnlyServicePrincipalKey(data acceptance.TestData, secret string, version int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdf%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+%[3]s
+
+resource "azurerm_data_factory_linked_service_data_lake_storage_gen2" "test" {
+  name                             = "acctestDataLake%[1]d"
+  data_factory_id                  = azurerm_data_factory.test.id
+  service_principal_id             = data.azurerm_client_config.current.client_id
+  service_principal_key_wo         = ephemeral.azurerm_key_vault_secret.test.value
+  service_principal_key_wo_version = %[4]d
+  tenant                           = "11111111-1111-1111-1111-111111111111"
+  url                              = "https://test.azure.com"
+}
+`, data.RandomInteger, data.Locations.Primary, acceptance.WriteOnlyKeyVaultSecretTemplate(data, secret), version)
+}
@@ -39,6 +39,8 @@ func resourceDataFactoryLinkedServiceKusto() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(linkedServiceServicePrincipalKeyCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -77,16 +79,31 @@ func resourceDataFactoryLinkedServiceKusto() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.IsUUID,
-				RequiredWith: []string{"service_principal_key"},
 				ExactlyOneOf: []string{"service_principal_id", "use_managed_identity"},
 			},
 
 			"service_principal_key": {
-				Type:         pluginsdk.TypeString,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				RequiredWith:  []string{"service_principal_id"},
+				ConflictsWith: []string{"service_principal_key_wo"},
+			},
+
+			"service_principal_key_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				RequiredWith:  []string{"service_principal_id", "service_principal_key_wo_version"},
+				ConflictsWith: []string{"service_principal_key"},
+			},
+
+			"service_principal_key_wo_version": {
+				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				Sensitive:    true,
-				ValidateFunc: validation.StringIsNotEmpty,
-				RequiredWith: []string{"service_principal_id"},
+				RequiredWith: []string{"service_principal_key_wo"},
 			},
 
 			"tenant": {
@@ -176,12 +193,17 @@ func resourceDataFactoryLinkedServiceKustoCreateUpdate(d *pluginsdk.ResourceData
 			Database: d.Get("kusto_database_name").(string),
 		}
 	} else if v, ok := d.GetOk("service_principal_id"); ok {
+		servicePrincipalKey, err := expandLinkedServiceServicePrincipalKey(d)
+		if err != nil {
+			return err
+		}
+
 		kustoLinkedService.AzureDataExplorerLinkedServiceTypeProperties = &datafactory.AzureDataExplorerLinkedServiceTypeProperties{
 			Endpoint:           d.Get("kusto_endpoint").(string),
 			Database:           d.Get("kusto_database_name").(string),
 			ServicePrincipalID: v.(string),
 			ServicePrincipalKey: &datafactory.SecureString{
-				Value: pointer.To(d.Get("service_principal_key").(string)),
+				Value: pointer.To(servicePrincipalKey),
 				Type:  datafactory.TypeSecureString,
 			},
 			Tenant: pointer.To(d.Get("tenant").(string)),
@@ -9,9 +9,13 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -107,6 +111,59 @@ func TestAccDataFactoryLinkedServiceKusto_update(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryLinkedServiceKusto_writeOnlyServicePrincipalKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_kusto", "test")
+	r := LinkedServiceKustoResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.writeOnlyServicePrincipalKey(data, "first-key", 1),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key_wo_version"),
+			{
+				Config: r.writeOnlyServicePrincipalKey(data, "second-key", 2),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key_wo_version"),
+		},
+	})
+}
+
+func TestAccDataFactoryLinkedServiceKusto_updateToWriteOnlyServicePrincipalKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_kusto", "test")
+	r := LinkedServiceKustoResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.servicePrincipal(data),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key"),
+			{
+				Config: r.writeOnlyServicePrincipalKey(data, "first-key", 1),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key", "service_principal_key_wo_version"),
+			{
+				Config: r.servicePrincipal(data),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key"),
+		},
+	})
+}
+
 func (t LinkedServiceKustoResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.LinkedServiceID(state.ID)
 	if err != nil {
@@ -235,3 +292,22 @@ resource "azurerm_kusto_database" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomString, data.RandomInteger)
 }
+
+func (r LinkedServiceKustoResource) writeOnlyServicePrincipalKey(data acceptance.TestData, secret string, version int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+%[2]s
+
+resource "azurerm_data_factory_linked_service_kusto" "test" {
+  name                             = "acctestlskusto%[3]d"
+  data_factory_id                  = azurerm_data_factory.test.id
+  kusto_endpoint                   = azurerm_kusto_cluster.test.uri
+  kusto_database_name              = azurerm_kusto_database.test.name
+  service_principal_id             = data.azurerm_client_config.current.client_id
+  service_principal_key_wo         = ephemeral.azurerm_key_vault_secret.test.value
+  service_principal_key_wo_version = %[4]d
+  tenant                           = data.azurerm_client_config.current.tenant_id
+}
+`, r.template(data), acceptance.WriteOnlyKeyVaultSecretTemplate(data, secret), data.RandomInteger, version)
+}
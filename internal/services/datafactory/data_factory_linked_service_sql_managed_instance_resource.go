@@ -23,18 +23,19 @@ import (
 type LinkedServiceSqlManagedInstanceResource struct{}
 
 type LinkedServiceSqlManagedInstanceModel struct {
-	Name                     string                           `tfschema:"name"`
-	DataFactoryID            string                           `tfschema:"data_factory_id"`
-	Annotations              []string                         `tfschema:"annotations"`
-	ConnectionString         string                           `tfschema:"connection_string"`
-	Description              string                           `tfschema:"description"`
-	IntegrationRuntimeName   string                           `tfschema:"integration_runtime_name"`
-	KeyVaultConnectionString []KeyVaultConnectionStringConfig `tfschema:"key_vault_connection_string"`
-	KeyVaultPassword         []KeyVaultPasswordConfig         `tfschema:"key_vault_password"`
-	Parameters               map[string]interface{}           `tfschema:"parameters"`
-	ServicePrincipalID       string                           `tfschema:"service_principal_id"`
-	ServicePrincipalKey      string                           `tfschema:"service_principal_key"`
-	Tenant                   string                           `tfschema:"tenant"`
+	Name                         string                           `tfschema:"name"`
+	DataFactoryID                string                           `tfschema:"data_factory_id"`
+	Annotations                  []string                         `tfschema:"annotations"`
+	ConnectionString             string                           `tfschema:"connection_string"`
+	Description                  string                           `tfschema:"description"`
+	IntegrationRuntimeName       string                           `tfschema:"integration_runtime_name"`
+	KeyVaultConnectionString     []KeyVaultConnectionStringConfig `tfschema:"key_vault_connection_string"`
+	KeyVaultPassword             []KeyVaultPasswordConfig         `tfschema:"key_vault_password"`
+	Parameters                   map[string]interface{}           `tfschema:"parameters"`
+	ServicePrincipalID           string                           `tfschema:"service_principal_id"`
+	ServicePrincipalKey          string                           `tfschema:"service_principal_key"`
+	ServicePrincipalKeyWoVersion int64                            `tfschema:"service_principal_key_wo_version"`
+	Tenant                       string                           `tfschema:"tenant"`
 }
 
 type KeyVaultConnectionStringConfig struct {
@@ -47,7 +48,10 @@ type KeyVaultPasswordConfig struct {
 	SecretName        string `tfschema:"secret_name"`
 }
 
-var _ sdk.ResourceWithUpdate = LinkedServiceSqlManagedInstanceResource{}
+var (
+	_ sdk.ResourceWithUpdate        = LinkedServiceSqlManagedInstanceResource{}
+	_ sdk.ResourceWithCustomizeDiff = LinkedServiceSqlManagedInstanceResource{}
+)
 
 func (r LinkedServiceSqlManagedInstanceResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -146,22 +150,38 @@ func (r LinkedServiceSqlManagedInstanceResource) Arguments() map[string]*plugins
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			ValidateFunc: validation.IsUUID,
-			RequiredWith: []string{"service_principal_key", "tenant"},
+			RequiredWith: []string{"tenant"},
 		},
 
 		"service_principal_key": {
-			Type:         pluginsdk.TypeString,
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			RequiredWith:  []string{"service_principal_id", "tenant"},
+			ConflictsWith: []string{"service_principal_key_wo"},
+		},
+
+		"service_principal_key_wo": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			WriteOnly:     true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			RequiredWith:  []string{"service_principal_id", "service_principal_key_wo_version", "tenant"},
+			ConflictsWith: []string{"service_principal_key"},
+		},
+
+		"service_principal_key_wo_version": {
+			Type:         pluginsdk.TypeInt,
 			Optional:     true,
-			Sensitive:    true,
-			ValidateFunc: validation.StringIsNotEmpty,
-			RequiredWith: []string{"service_principal_id", "tenant"},
+			RequiredWith: []string{"service_principal_key_wo"},
 		},
 
 		"tenant": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			ValidateFunc: validation.IsUUID,
-			RequiredWith: []string{"service_principal_id", "service_principal_key"},
+			RequiredWith: []string{"service_principal_id"},
 		},
 	}
 }
@@ -229,8 +249,13 @@ func (r LinkedServiceSqlManagedInstanceResource) Create() sdk.ResourceFunc {
 				sqlMILinkedService.TypeProperties.ServicePrincipalId = pointer.To(interface{}(config.ServicePrincipalID))
 			}
 
-			if config.ServicePrincipalKey != "" {
-				secureString := expandLinkedServiceSqlManagedInstanceServicePrincipalKey(config.ServicePrincipalKey)
+			servicePrincipalKey, err := expandLinkedServiceServicePrincipalKey(metadata.ResourceData)
+			if err != nil {
+				return err
+			}
+
+			if servicePrincipalKey != "" {
+				secureString := expandLinkedServiceSqlManagedInstanceServicePrincipalKey(servicePrincipalKey)
 				sqlMILinkedService.TypeProperties.ServicePrincipalKey = secureString
 				sqlMILinkedService.TypeProperties.ServicePrincipalCredential = secureString
 			}
@@ -255,6 +280,15 @@ func (r LinkedServiceSqlManagedInstanceResource) Create() sdk.ResourceFunc {
 	}
 }
 
+func (r LinkedServiceSqlManagedInstanceResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return linkedServiceServicePrincipalKeyCustomizeDiff(ctx, metadata.ResourceDiff, metadata.Client)
+		},
+	}
+}
+
 func (r LinkedServiceSqlManagedInstanceResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -295,13 +329,14 @@ func (r LinkedServiceSqlManagedInstanceResource) Read() sdk.ResourceFunc {
 			props := sqlMILinkedService.TypeProperties
 
 			state := LinkedServiceSqlManagedInstanceModel{
-				Name:                id.LinkedServiceName,
-				DataFactoryID:       factories.NewFactoryID(id.SubscriptionId, id.ResourceGroupName, id.FactoryName).ID(),
-				Annotations:         flattenLinkedServiceSqlManagedInstanceAnnotations(sqlMILinkedService.Annotations),
-				Description:         pointer.From(sqlMILinkedService.Description),
-				KeyVaultPassword:    flattenLinkedServiceSqlManagedInstanceKeyVaultPassword(props.Password),
-				Parameters:          flattenLinkedServiceSqlManagedInstanceParameters(sqlMILinkedService.Parameters),
-				ServicePrincipalKey: metadata.ResourceData.Get("service_principal_key").(string),
+				Name:                         id.LinkedServiceName,
+				DataFactoryID:                factories.NewFactoryID(id.SubscriptionId, id.ResourceGroupName, id.FactoryName).ID(),
+				Annotations:                  flattenLinkedServiceSqlManagedInstanceAnnotations(sqlMILinkedService.Annotations),
+				Description:                  pointer.From(sqlMILinkedService.Description),
+				KeyVaultPassword:             flattenLinkedServiceSqlManagedInstanceKeyVaultPassword(props.Password),
+				Parameters:                   flattenLinkedServiceSqlManagedInstanceParameters(sqlMILinkedService.Parameters),
+				ServicePrincipalKey:          metadata.ResourceData.Get("service_principal_key").(string),
+				ServicePrincipalKeyWoVersion: int64(metadata.ResourceData.Get("service_principal_key_wo_version").(int)),
 			}
 
 			if props.ConnectionString != nil {
@@ -381,11 +416,16 @@ func (r LinkedServiceSqlManagedInstanceResource) Update() sdk.ResourceFunc {
 				typeProps.ServicePrincipalId = pointer.To(interface{}(config.ServicePrincipalID))
 			}
 
-			if metadata.ResourceData.HasChange("service_principal_key") {
-				secureString := expandLinkedServiceSqlManagedInstanceServicePrincipalKey(config.ServicePrincipalKey)
+			servicePrincipalKey, err := expandLinkedServiceServicePrincipalKey(metadata.ResourceData)
+			if err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.HasChanges("service_principal_key", "service_principal_key_wo_version") {
+				secureString := expandLinkedServiceSqlManagedInstanceServicePrincipalKey(servicePrincipalKey)
 				typeProps.ServicePrincipalKey = secureString
 				typeProps.ServicePrincipalCredential = secureString
-			} else if config.ServicePrincipalKey == "" {
+			} else if servicePrincipalKey == "" {
 				typeProps.ServicePrincipalKey = nil
 				typeProps.ServicePrincipalCredential = nil
 			}
@@ -10,9 +10,13 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/linkedservices"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -189,6 +193,59 @@ func TestAccDataFactoryLinkedServiceSQLManagedInstance_keyVaultConnectionString(
 	})
 }
 
+func TestAccDataFactoryLinkedServiceSQLManagedInstance_writeOnlyServicePrincipalKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_sql_managed_instance", "test")
+	r := LinkedServiceSQLManagedInstanceResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.writeOnlyServicePrincipalKey(data, "first-key", 1),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key_wo_version"),
+			{
+				Config: r.writeOnlyServicePrincipalKey(data, "second-key", 2),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key_wo_version"),
+		},
+	})
+}
+
+func TestAccDataFactoryLinkedServiceSQLManagedInstance_updateToWriteOnlyServicePrincipalKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_linked_service_sql_managed_instance", "test")
+	r := LinkedServiceSQLManagedInstanceResource{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.servicePrincipal(data),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key"),
+			{
+				Config: r.writeOnlyServicePrincipalKey(data, "first-key", 1),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key", "service_principal_key_wo_version"),
+			{
+				Config: r.servicePrincipal(data),
+				Check:  check.That(data.ResourceName).ExistsInAzure(r),
+			},
+			data.ImportStep("service_principal_key"),
+		},
+	})
+}
+
 func (r LinkedServiceSQLManagedInstanceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := linkedservices.ParseLinkedServiceID(state.ID)
 	if err != nil {
@@ -405,3 +462,21 @@ resource "azurerm_data_factory_integration_runtime_azure" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (r LinkedServiceSQLManagedInstanceResource) writeOnlyServicePrincipalKey(data acceptance.TestData, secret string, version int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+%[2]s
+
+resource "azurerm_data_factory_linked_service_sql_managed_instance" "test" {
+  name                             = "acctestlssqlmi%[3]d"
+  data_factory_id                  = azurerm_data_factory.test.id
+  connection_string                = "Server=myserver.database.windows.net;Database=mydatabase"
+  service_principal_id             = "00000000-0000-0000-0000-000000000000"
+  service_principal_key_wo         = ephemeral.azurerm_key_vault_secret.test.value
+  service_principal_key_wo_version = %[4]d
+  tenant                           = "11111111-1111-1111-1111-111111111111"
+}
+`, r.template(data), acceptance.WriteOnlyKeyVaultSecretTemplate(data, secret), data.RandomInteger, version)
+}
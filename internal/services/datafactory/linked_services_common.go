@@ -4,8 +4,12 @@
 package datafactory
 
 import (
+	"context"
+	"fmt"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/jackofallops/kermit/sdk/datafactory/2018-06-01/datafactory" // nolint: staticcheck
 )
 
@@ -39,3 +43,45 @@ func flattenLinkedServiceParameters(input map[string]*datafactory.ParameterSpeci
 
 	return output
 }
+
+// linkedServiceServicePrincipalKeyCustomizeDiff ensures that one of `service_principal_key` or `service_principal_key_wo`
+// is specified alongside `service_principal_id`, which can't be expressed using `RequiredWith`
+func linkedServiceServicePrincipalKeyCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	servicePrincipalId, diags := d.GetRawConfigAt(cty.GetAttrPath("service_principal_id"))
+	if diags.HasError() {
+		return fmt.Errorf("retrieving `service_principal_id`: %+v", diags)
+	}
+	if servicePrincipalId.IsNull() {
+		return nil
+	}
+
+	servicePrincipalKey, diags := d.GetRawConfigAt(cty.GetAttrPath("service_principal_key"))
+	if diags.HasError() {
+		return fmt.Errorf("retrieving `service_principal_key`: %+v", diags)
+	}
+
+	woServicePrincipalKey, err := pluginsdk.GetWriteOnlyFromDiff(d, "service_principal_key_wo", cty.String)
+	if err != nil {
+		return err
+	}
+
+	if servicePrincipalKey.IsNull() && woServicePrincipalKey.IsNull() {
+		return fmt.Errorf("one of `service_principal_key` or `service_principal_key_wo` must be specified when `service_principal_id` is set")
+	}
+
+	return nil
+}
+
+// expandLinkedServiceServicePrincipalKey returns the value of `service_principal_key_wo` when it's specified, otherwise
+// the value of `service_principal_key`
+func expandLinkedServiceServicePrincipalKey(d *pluginsdk.ResourceData) (string, error) {
+	woServicePrincipalKey, err := pluginsdk.GetWriteOnly(d, "service_principal_key_wo", cty.String)
+	if err != nil {
+		return "", err
+	}
+	if !woServicePrincipalKey.IsNull() {
+		return woServicePrincipalKey.AsString(), nil
+	}
+
+	return d.Get("service_principal_key").(string), nil
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = DnsZoneFileDataSource{}
+
+type DnsZoneFileDataSource struct{}
+
+type DnsZoneFileDataSourceModel struct {
+	DnsZoneId string `tfschema:"dns_zone_id"`
+	Content   string `tfschema:"content"`
+}
+
+func (DnsZoneFileDataSource) ModelObject() interface{} {
+	return &DnsZoneFileDataSourceModel{}
+}
+
+func (DnsZoneFileDataSource) ResourceType() string {
+	return "azurerm_dns_zone_file"
+}
+
+func (DnsZoneFileDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dns_zone_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: zones.ValidateDnsZoneID,
+		},
+	}
+}
+
+func (DnsZoneFileDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (DnsZoneFileDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			zonesClient := metadata.Client.Dns.Zones
+			client := metadata.Client.Dns.RecordSets
+
+			var state DnsZoneFileDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := zones.ParseDnsZoneID(state.DnsZoneId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := zonesClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			resp, err := client.ListAllByDnsZoneComplete(ctx, recordsets.NewDnsZoneID(id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName), recordsets.DefaultListAllByDnsZoneOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing record sets for %s: %+v", id, err)
+			}
+
+			zone := zonefile.Zone{
+				Origin:     zonefile.Fqdn(id.DnsZoneName),
+				RecordSets: make([]zonefile.RecordSet, 0),
+			}
+			for _, item := range resp.Items {
+				if item.Properties != nil && item.Properties.SOARecord != nil {
+					zone.SOA = flattenDnsZoneFileSOA(pointer.From(item.Properties.TTL), *item.Properties.SOARecord)
+					continue
+				}
+
+				if rs := flattenDnsZoneRecordsRecordSet(item); rs != nil {
+					zone.RecordSets = append(zone.RecordSets, *rs)
+				}
+			}
+
+			metadata.SetID(id)
+
+			state.DnsZoneId = id.ID()
+			state.Content = zonefile.Render(zone)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenDnsZoneFileSOA(ttl int64, input recordsets.SoaRecord) *zonefile.SOA {
+	return &zonefile.SOA{
+		TTL:     ttl,
+		Host:    zonefile.Fqdn(pointer.From(input.Host)),
+		Email:   zonefile.Fqdn(pointer.From(input.Email)),
+		Serial:  pointer.From(input.SerialNumber),
+		Refresh: pointer.From(input.RefreshTime),
+		Retry:   pointer.From(input.RetryTime),
+		Expire:  pointer.From(input.ExpireTime),
+		Minimum: pointer.From(input.MinimumTTL),
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DnsZoneFileDataSource struct{}
+
+func TestAccDnsZoneFileDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dns_zone_file", "test")
+	r := DnsZoneFileDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("content").MatchesRegex(regexp.MustCompile(fmt.Sprintf(`^\$ORIGIN acctestzone%d\.com\.\n@\t3600\tIN\tSOA\t`, data.RandomInteger))),
+				check.That(data.ResourceName).Key("content").MatchesRegex(regexp.MustCompile(`\n@\t172800\tIN\tNS\t`)),
+				check.That(data.ResourceName).Key("content").MatchesRegex(regexp.MustCompile(`\n@\t3600\tIN\tMX\t10 mail\.example\.net\.\n`)),
+				check.That(data.ResourceName).Key("content").MatchesRegex(regexp.MustCompile(`\nwww\t300\tIN\tA\t192\.0\.2\.1\nwww\t300\tIN\tA\t192\.0\.2\.2\n`)),
+			),
+		},
+	})
+}
+
+func (DnsZoneFileDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  zone_file   = <<ZONE
+@    IN MX  10 mail.example.net.
+www  300 IN A   192.0.2.2
+www  300 IN A   192.0.2.1
+ZONE
+}
+
+data "azurerm_dns_zone_file" "test" {
+  dns_zone_id = azurerm_dns_zone_records.test.dns_zone_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
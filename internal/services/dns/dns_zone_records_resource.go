@@ -0,0 +1,579 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type DnsZoneRecordsResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = DnsZoneRecordsResource{}
+	_ sdk.ResourceWithCustomizeDiff = DnsZoneRecordsResource{}
+)
+
+type DnsZoneRecordsResourceModel struct {
+	DnsZoneId     string                                 `tfschema:"dns_zone_id"`
+	ZoneFile      string                                 `tfschema:"zone_file"`
+	Authoritative bool                                   `tfschema:"authoritative"`
+	DefaultTTL    int64                                  `tfschema:"default_ttl"`
+	RecordSet     []DnsZoneRecordsRecordSetResourceModel `tfschema:"record_set"`
+}
+
+type DnsZoneRecordsRecordSetResourceModel struct {
+	Name    string   `tfschema:"name"`
+	Type    string   `tfschema:"type"`
+	TTL     int64    `tfschema:"ttl"`
+	Records []string `tfschema:"records"`
+}
+
+func (DnsZoneRecordsResource) ModelObject() interface{} {
+	return &DnsZoneRecordsResourceModel{}
+}
+
+func (DnsZoneRecordsResource) ResourceType() string {
+	return "azurerm_dns_zone_records"
+}
+
+func (DnsZoneRecordsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return zones.ValidateDnsZoneID
+}
+
+func (DnsZoneRecordsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dns_zone_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: zones.ValidateDnsZoneID,
+		},
+
+		"zone_file": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"authoritative": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"default_ttl": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      3600,
+			ValidateFunc: validation.IntBetween(0, 2147483647),
+		},
+	}
+}
+
+func (DnsZoneRecordsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"record_set": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"ttl": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"records": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r DnsZoneRecordsResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			// the name of the zone is required to qualify relative names, which isn't known until the zone exists
+			if !diff.NewValueKnown("dns_zone_id") || !diff.NewValueKnown("zone_file") {
+				return diff.SetNewComputed("record_set")
+			}
+
+			zoneId, err := zones.ParseDnsZoneID(diff.Get("dns_zone_id").(string))
+			if err != nil {
+				return err
+			}
+
+			zone, err := zonefile.Parse(diff.Get("zone_file").(string), zoneId.DnsZoneName, int64(diff.Get("default_ttl").(int)))
+			if err != nil {
+				return fmt.Errorf("parsing `zone_file`: %+v", err)
+			}
+
+			// the record sets in state reflect the zone, so any difference to the zone file requires an update
+			expected := flattenDnsZoneRecordsRecordSets(zonefile.Managed(zone.RecordSets, zone.RecordSets, true))
+
+			var state DnsZoneRecordsResourceModel
+			if err := metadata.DecodeDiff(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if !dnsZoneRecordsRecordSetsEqual(state.RecordSet, expected) {
+				return diff.SetNew("record_set", expected)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DnsZoneRecordsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			zonesClient := metadata.Client.Dns.Zones
+
+			var model DnsZoneRecordsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := zones.ParseDnsZoneID(model.DnsZoneId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := zonesClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// record sets which already exist would be taken over by this resource - and when authoritative, the record
+			// sets not declared in the zone file deleted - so these need to be imported first
+			zone, err := zonefile.Parse(model.ZoneFile, id.DnsZoneName, model.DefaultTTL)
+			if err != nil {
+				return fmt.Errorf("parsing `zone_file`: %+v", err)
+			}
+
+			existingRecordSets, _, err := r.listRecordSets(ctx, metadata, *id)
+			if err != nil {
+				return fmt.Errorf("checking for existing record sets in %s: %+v", id, err)
+			}
+
+			if !metadata.Client.Features.SkipImportCheckOnCreateAndAllowOverwritingExistingResources && len(zonefile.Managed(zone.RecordSets, existingRecordSets, model.Authoritative)) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := r.reconcile(ctx, metadata, *id, model, nil); err != nil {
+				return fmt.Errorf("creating records for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r DnsZoneRecordsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			zonesClient := metadata.Client.Dns.Zones
+
+			id, err := zones.ParseDnsZoneID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state DnsZoneRecordsResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := zonesClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state.DnsZoneId = id.ID()
+			if state.DefaultTTL == 0 {
+				state.DefaultTTL = 3600
+			}
+
+			// when imported there's no zone file, in which case every record set in the zone is exposed
+			declared := make([]zonefile.RecordSet, 0)
+			authoritative := state.Authoritative || state.ZoneFile == ""
+			if state.ZoneFile != "" {
+				zone, err := zonefile.Parse(state.ZoneFile, id.DnsZoneName, state.DefaultTTL)
+				if err != nil {
+					return fmt.Errorf("parsing `zone_file`: %+v", err)
+				}
+				declared = zone.RecordSets
+			}
+
+			existingRecordSets, _, err := r.listRecordSets(ctx, metadata, *id)
+			if err != nil {
+				return fmt.Errorf("listing record sets for %s: %+v", id, err)
+			}
+
+			state.RecordSet = flattenDnsZoneRecordsRecordSets(zonefile.Managed(declared, existingRecordSets, authoritative))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DnsZoneRecordsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := zones.ParseDnsZoneID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DnsZoneRecordsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// record sets which were removed from the zone file are deleted even when the resource isn't authoritative
+			previouslyDeclared := make([]zonefile.RecordSet, 0)
+			oldZoneFile, _ := metadata.ResourceData.GetChange("zone_file")
+			oldDefaultTTL, _ := metadata.ResourceData.GetChange("default_ttl")
+			if v := oldZoneFile.(string); v != "" {
+				previous, err := zonefile.Parse(v, id.DnsZoneName, int64(oldDefaultTTL.(int)))
+				if err == nil {
+					previouslyDeclared = previous.RecordSets
+				}
+			}
+
+			if err := r.reconcile(ctx, metadata, *id, model, previouslyDeclared); err != nil {
+				return fmt.Errorf("updating records for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DnsZoneRecordsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.RecordSets
+
+			id, err := zones.ParseDnsZoneID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model DnsZoneRecordsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			zone, err := zonefile.Parse(model.ZoneFile, id.DnsZoneName, model.DefaultTTL)
+			if err != nil {
+				return fmt.Errorf("parsing `zone_file`: %+v", err)
+			}
+
+			// only the declared record sets are removed, any others were either removed when authoritative or aren't
+			// managed by this resource
+			for _, rs := range zone.RecordSets {
+				if rs.IsManagedByAzure() {
+					continue
+				}
+
+				recordSetId := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName, recordsets.RecordType(rs.Type), rs.Name)
+				if resp, err := client.Delete(ctx, recordSetId, recordsets.DefaultDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", recordSetId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// reconcile creates, updates and deletes the record sets in the zone so that they match the zone file
+func (r DnsZoneRecordsResource) reconcile(ctx context.Context, metadata sdk.ResourceMetaData, id zones.DnsZoneId, model DnsZoneRecordsResourceModel, previouslyDeclared []zonefile.RecordSet) error {
+	client := metadata.Client.Dns.RecordSets
+
+	zone, err := zonefile.Parse(model.ZoneFile, id.DnsZoneName, model.DefaultTTL)
+	if err != nil {
+		return fmt.Errorf("parsing `zone_file`: %+v", err)
+	}
+
+	existing, existingMetadata, err := r.listRecordSets(ctx, metadata, id)
+	if err != nil {
+		return fmt.Errorf("listing record sets: %+v", err)
+	}
+
+	changes := zonefile.Reconcile(zone.RecordSets, existing, previouslyDeclared, model.Authoritative)
+
+	for _, rs := range changes.Deletions {
+		recordSetId := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName, recordsets.RecordType(rs.Type), rs.Name)
+		if resp, err := client.Delete(ctx, recordSetId, recordsets.DefaultDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", recordSetId, err)
+		}
+	}
+
+	for _, rs := range changes.Upserts {
+		recordSetId := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName, recordsets.RecordType(rs.Type), rs.Name)
+		parameters := expandDnsZoneRecordsRecordSet(rs, existingMetadata[rs.Key()])
+		if _, err := client.CreateOrUpdate(ctx, recordSetId, parameters, recordsets.DefaultCreateOrUpdateOperationOptions()); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", recordSetId, err)
+		}
+	}
+
+	return nil
+}
+
+// listRecordSets returns every record set within the zone, along with the metadata of each record set keyed by the
+// record set key so that it can be retained when the record set is updated
+func (r DnsZoneRecordsResource) listRecordSets(ctx context.Context, metadata sdk.ResourceMetaData, id zones.DnsZoneId) ([]zonefile.RecordSet, map[string]*map[string]string, error) {
+	client := metadata.Client.Dns.RecordSets
+
+	resp, err := client.ListAllByDnsZoneComplete(ctx, recordsets.NewDnsZoneID(id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName), recordsets.DefaultListAllByDnsZoneOperationOptions())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := make([]zonefile.RecordSet, 0)
+	recordSetMetadata := make(map[string]*map[string]string)
+	for _, item := range resp.Items {
+		rs := flattenDnsZoneRecordsRecordSet(item)
+		if rs == nil {
+			continue
+		}
+		result = append(result, *rs)
+		if item.Properties != nil {
+			recordSetMetadata[rs.Key()] = item.Properties.Metadata
+		}
+	}
+
+	return result, recordSetMetadata, nil
+}
+
+func expandDnsZoneRecordsRecordSet(input zonefile.RecordSet, metadata *map[string]string) recordsets.RecordSet {
+	props := recordsets.RecordSetProperties{
+		Metadata: metadata,
+		TTL:      pointer.To(input.TTL),
+	}
+
+	switch input.Type {
+	case zonefile.RecordTypeA:
+		records := make([]recordsets.ARecord, 0)
+		for _, v := range input.Records {
+			records = append(records, recordsets.ARecord{IPv4Address: pointer.To(v.Value)})
+		}
+		props.ARecords = &records
+	case zonefile.RecordTypeAAAA:
+		records := make([]recordsets.AaaaRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, recordsets.AaaaRecord{IPv6Address: pointer.To(v.Value)})
+		}
+		props.AAAARecords = &records
+	case zonefile.RecordTypeCAA:
+		records := make([]recordsets.CaaRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, recordsets.CaaRecord{
+				Flags: pointer.To(v.Flags),
+				Tag:   pointer.To(v.Tag),
+				Value: pointer.To(v.Value),
+			})
+		}
+		props.CaaRecords = &records
+	case zonefile.RecordTypeCNAME:
+		if len(input.Records) > 0 {
+			props.CNAMERecord = &recordsets.CnameRecord{Cname: pointer.To(strings.TrimSuffix(input.Records[0].Value, "."))}
+		}
+	case zonefile.RecordTypeMX:
+		records := make([]recordsets.MxRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, recordsets.MxRecord{
+				Preference: pointer.To(v.Preference),
+				Exchange:   pointer.To(strings.TrimSuffix(v.Exchange, ".")),
+			})
+		}
+		props.MXRecords = &records
+	case zonefile.RecordTypeNS:
+		records := make([]recordsets.NsRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, recordsets.NsRecord{Nsdname: pointer.To(strings.TrimSuffix(v.Value, "."))})
+		}
+		props.NSRecords = &records
+	case zonefile.RecordTypePTR:
+		records := make([]recordsets.PtrRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, recordsets.PtrRecord{Ptrdname: pointer.To(strings.TrimSuffix(v.Value, "."))})
+		}
+		props.PTRRecords = &records
+	case zonefile.RecordTypeSRV:
+		records := make([]recordsets.SrvRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, recordsets.SrvRecord{
+				Priority: pointer.To(v.Priority),
+				Weight:   pointer.To(v.Weight),
+				Port:     pointer.To(v.Port),
+				Target:   pointer.To(strings.TrimSuffix(v.Target, ".")),
+			})
+		}
+		props.SRVRecords = &records
+	case zonefile.RecordTypeTXT:
+		records := make([]recordsets.TxtRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, recordsets.TxtRecord{Value: pointer.To(v.Text)})
+		}
+		props.TXTRecords = &records
+	}
+
+	return recordsets.RecordSet{
+		Name:       pointer.To(input.Name),
+		Properties: &props,
+	}
+}
+
+// flattenDnsZoneRecordsRecordSet converts a record set into its zone file representation, returning nil for record
+// types which can't be represented
+func flattenDnsZoneRecordsRecordSet(input recordsets.RecordSet) *zonefile.RecordSet {
+	if input.Name == nil || input.Type == nil || input.Properties == nil {
+		return nil
+	}
+
+	// the type is returned in the format `Microsoft.Network/dnszones/A`
+	typeSegments := strings.Split(*input.Type, "/")
+	result := zonefile.RecordSet{
+		Name:    strings.ToLower(*input.Name),
+		Type:    zonefile.RecordType(strings.ToUpper(typeSegments[len(typeSegments)-1])),
+		TTL:     pointer.From(input.Properties.TTL),
+		Records: make([]zonefile.Record, 0),
+	}
+
+	props := input.Properties
+	switch result.Type {
+	case zonefile.RecordTypeA:
+		for _, v := range pointer.From(props.ARecords) {
+			result.Records = append(result.Records, zonefile.Record{Value: zonefile.NormalizeAddress(pointer.From(v.IPv4Address))})
+		}
+	case zonefile.RecordTypeAAAA:
+		for _, v := range pointer.From(props.AAAARecords) {
+			result.Records = append(result.Records, zonefile.Record{Value: zonefile.NormalizeAddress(pointer.From(v.IPv6Address))})
+		}
+	case zonefile.RecordTypeCAA:
+		for _, v := range pointer.From(props.CaaRecords) {
+			result.Records = append(result.Records, zonefile.Record{
+				Flags: pointer.From(v.Flags),
+				Tag:   strings.ToLower(pointer.From(v.Tag)),
+				Value: pointer.From(v.Value),
+			})
+		}
+	case zonefile.RecordTypeCNAME:
+		if props.CNAMERecord != nil && props.CNAMERecord.Cname != nil {
+			result.Records = append(result.Records, zonefile.Record{Value: zonefile.Fqdn(*props.CNAMERecord.Cname)})
+		}
+	case zonefile.RecordTypeMX:
+		for _, v := range pointer.From(props.MXRecords) {
+			result.Records = append(result.Records, zonefile.Record{
+				Preference: pointer.From(v.Preference),
+				Exchange:   zonefile.Fqdn(pointer.From(v.Exchange)),
+			})
+		}
+	case zonefile.RecordTypeNS:
+		for _, v := range pointer.From(props.NSRecords) {
+			result.Records = append(result.Records, zonefile.Record{Value: zonefile.Fqdn(pointer.From(v.Nsdname))})
+		}
+	case zonefile.RecordTypePTR:
+		for _, v := range pointer.From(props.PTRRecords) {
+			result.Records = append(result.Records, zonefile.Record{Value: zonefile.Fqdn(pointer.From(v.Ptrdname))})
+		}
+	case zonefile.RecordTypeSRV:
+		for _, v := range pointer.From(props.SRVRecords) {
+			result.Records = append(result.Records, zonefile.Record{
+				Priority: pointer.From(v.Priority),
+				Weight:   pointer.From(v.Weight),
+				Port:     pointer.From(v.Port),
+				Target:   zonefile.Fqdn(pointer.From(v.Target)),
+			})
+		}
+	case zonefile.RecordTypeTXT:
+		for _, v := range pointer.From(props.TXTRecords) {
+			result.Records = append(result.Records, zonefile.Record{Text: pointer.From(v.Value)})
+		}
+	case zonefile.RecordTypeSOA:
+		// the SOA record is managed by Azure and exposed through the `azurerm_dns_zone` resource
+	default:
+		return nil
+	}
+
+	return &result
+}
+
+func flattenDnsZoneRecordsRecordSets(input []zonefile.RecordSet) []DnsZoneRecordsRecordSetResourceModel {
+	result := make([]DnsZoneRecordsRecordSetResourceModel, 0)
+	for _, rs := range input {
+		result = append(result, DnsZoneRecordsRecordSetResourceModel{
+			Name:    rs.Name,
+			Type:    string(rs.Type),
+			TTL:     rs.TTL,
+			Records: rs.Values(),
+		})
+	}
+	return result
+}
+
+func dnsZoneRecordsRecordSetsEqual(a, b []DnsZoneRecordsRecordSetResourceModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Type != b[i].Type || a[i].TTL != b[i].TTL || len(a[i].Records) != len(b[i].Records) {
+			return false
+		}
+		for j := range a[i].Records {
+			if a[i].Records[j] != b[i].Records[j] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DnsZoneRecordsResource struct{}
+
+func TestAccDnsZoneRecords_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("zone_file", "authoritative", "default_ttl"),
+	})
+}
+
+func TestAccDnsZoneRecords_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDnsZoneRecords_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("7"),
+			),
+		},
+		data.ImportStep("zone_file", "authoritative", "default_ttl"),
+	})
+}
+
+func TestAccDnsZoneRecords_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("zone_file", "authoritative", "default_ttl"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("7"),
+			),
+		},
+		data.ImportStep("zone_file", "authoritative", "default_ttl"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("zone_file", "authoritative", "default_ttl"),
+	})
+}
+
+func TestAccDnsZoneRecords_authoritative(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_zone_records", "test")
+	r := DnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.unmanagedRecord(data),
+		},
+		{
+			Config: r.additive(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("2"),
+			),
+		},
+		{
+			// the unmanaged record set is removed from the zone when the resource becomes authoritative
+			Config: r.authoritative(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("zone_file", "authoritative", "default_ttl"),
+	})
+}
+
+func (DnsZoneRecordsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := zones.ParseDnsZoneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	recordSetId := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName, recordsets.RecordTypeA, "www")
+	resp, err := clients.Dns.RecordSets.Get(ctx, recordSetId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", recordSetId, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (DnsZoneRecordsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DnsZoneRecordsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  zone_file   = <<ZONE
+www  IN A    192.0.2.1
+www  IN A    192.0.2.2
+ftp  IN CNAME www
+ZONE
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "import" {
+  dns_zone_id = azurerm_dns_zone_records.test.dns_zone_id
+  zone_file   = azurerm_dns_zone_records.test.zone_file
+}
+`, r.basic(data))
+}
+
+func (r DnsZoneRecordsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id   = azurerm_dns_zone.test.id
+  authoritative = true
+  default_ttl   = 300
+  zone_file     = <<ZONE
+$TTL 1h
+@          IN MX    10 mail
+@          IN MX    20 mail.example.net.
+@          IN TXT   "v=spf1 -all"
+@          IN CAA   0 issue "letsencrypt.org"
+www   600  IN A     192.0.2.1
+www        IN AAAA  2001:db8::1
+_sip._tcp  IN SRV   10 60 5060 sip.example.net.
+delegated  IN NS    ns1.example.net.
+ZONE
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) unmanagedRecord(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_a_record" "unmanaged" {
+  name                = "unmanaged"
+  zone_name           = azurerm_dns_zone.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  ttl                 = 300
+  records             = ["192.0.2.10"]
+}
+`, r.template(data))
+}
+
+func (r DnsZoneRecordsResource) additive(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id = azurerm_dns_zone.test.id
+  zone_file   = <<ZONE
+www  IN A    192.0.2.1
+ftp  IN CNAME www
+ZONE
+}
+`, r.unmanagedRecord(data))
+}
+
+func (r DnsZoneRecordsResource) authoritative(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_zone_records" "test" {
+  dns_zone_id   = azurerm_dns_zone.test.id
+  authoritative = true
+  zone_file     = <<ZONE
+www  IN A    192.0.2.1
+ftp  IN CNAME www
+ZONE
+}
+`, r.template(data))
+}
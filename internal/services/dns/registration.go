@@ -69,6 +69,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		DnsZoneDataResource{},
+		DnsZoneFileDataSource{},
 	}
 }
 
@@ -76,6 +77,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		DnsZoneResource{},
+		DnsZoneRecordsResource{},
 	}
 }
 
@@ -0,0 +1,500 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+)
+
+type token struct {
+	Value  string
+	Quoted bool
+}
+
+// entry is a single logical line of a zone file, which may span multiple lines when parentheses are used
+type entry struct {
+	Line       int
+	BlankOwner bool
+	Tokens     []token
+}
+
+// Parse parses the content of an RFC 1035 zone file for the zone named origin. Relative names are qualified using
+// the origin, which can be changed within the file using `$ORIGIN`. Records without a TTL use the value of `$TTL`, or
+// the TTL of the previous record, falling back to defaultTTL.
+func Parse(content, origin string, defaultTTL int64) (*Zone, error) {
+	entries, err := tokenize(content)
+	if err != nil {
+		return nil, err
+	}
+
+	zoneOrigin := Fqdn(origin)
+	currentOrigin := zoneOrigin
+	currentTTL := int64(-1)
+	lastTTL := int64(-1)
+	lastOwner := ""
+
+	zone := Zone{
+		Origin: zoneOrigin,
+	}
+	recordSets := make(map[string]*RecordSet)
+	keys := make([]string, 0)
+
+	for _, e := range entries {
+		tokens := e.Tokens
+
+		if !e.BlankOwner && !tokens[0].Quoted && strings.HasPrefix(tokens[0].Value, "$") {
+			directive := strings.ToUpper(tokens[0].Value)
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: `$ORIGIN` expects a single domain name", e.Line)
+				}
+				currentOrigin = qualify(tokens[1].Value, currentOrigin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: `$TTL` expects a single TTL", e.Line)
+				}
+				ttl, err := parseTTL(tokens[1].Value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %+v", e.Line, err)
+				}
+				currentTTL = ttl
+			default:
+				return nil, fmt.Errorf("line %d: the directive `%s` is not supported", e.Line, directive)
+			}
+			continue
+		}
+
+		owner := lastOwner
+		if !e.BlankOwner {
+			owner = qualify(tokens[0].Value, currentOrigin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: the record doesn't specify an owner name", e.Line)
+		}
+		lastOwner = owner
+
+		ttl := int64(-1)
+		for len(tokens) > 0 && !tokens[0].Quoted {
+			if v, err := parseTTL(tokens[0].Value); err == nil && ttl == -1 {
+				ttl = v
+				tokens = tokens[1:]
+				continue
+			}
+
+			class := strings.ToUpper(tokens[0].Value)
+			if class == "IN" {
+				tokens = tokens[1:]
+				continue
+			}
+			if class == "CH" || class == "HS" || class == "CS" {
+				return nil, fmt.Errorf("line %d: only the `IN` class is supported but got %q", e.Line, tokens[0].Value)
+			}
+			break
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: the record doesn't specify a type", e.Line)
+		}
+
+		if ttl == -1 {
+			switch {
+			case currentTTL != -1:
+				ttl = currentTTL
+			case lastTTL != -1:
+				ttl = lastTTL
+			default:
+				ttl = defaultTTL
+			}
+		} else {
+			lastTTL = ttl
+		}
+
+		name, err := relativeName(owner, zoneOrigin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %+v", e.Line, err)
+		}
+
+		recordType := RecordType(strings.ToUpper(tokens[0].Value))
+		data := tokens[1:]
+
+		if recordType == RecordTypeSOA {
+			if name != ApexName {
+				return nil, fmt.Errorf("line %d: the SOA record must be at the apex of the zone", e.Line)
+			}
+			if zone.SOA != nil {
+				return nil, fmt.Errorf("line %d: the zone contains more than one SOA record", e.Line)
+			}
+			soa, err := parseSOA(data, currentOrigin)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %+v", e.Line, err)
+			}
+			soa.TTL = ttl
+			zone.SOA = soa
+			continue
+		}
+
+		record, err := parseRecord(recordType, data, currentOrigin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %+v", e.Line, err)
+		}
+
+		rs := RecordSet{
+			Name: name,
+			Type: recordType,
+		}
+		existing, ok := recordSets[rs.Key()]
+		if !ok {
+			// every record in a record set shares a TTL, so the TTL of the first record is used
+			rs.TTL = ttl
+			recordSets[rs.Key()] = &rs
+			keys = append(keys, rs.Key())
+			existing = &rs
+		}
+
+		duplicate := false
+		for _, r := range existing.Records {
+			if r.String(recordType) == record.String(recordType) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			existing.Records = append(existing.Records, *record)
+		}
+	}
+
+	names := make(map[string][]RecordType)
+	for _, key := range keys {
+		rs := *recordSets[key]
+		names[rs.Name] = append(names[rs.Name], rs.Type)
+		zone.RecordSets = append(zone.RecordSets, rs)
+	}
+
+	for _, rs := range zone.RecordSets {
+		if rs.Type != RecordTypeCNAME {
+			continue
+		}
+		if len(rs.Records) > 1 {
+			return nil, fmt.Errorf("the CNAME record set %q contains more than one record", rs.Name)
+		}
+		if len(names[rs.Name]) > 1 {
+			return nil, fmt.Errorf("the CNAME record set %q can't coexist with other record types", rs.Name)
+		}
+	}
+
+	SortRecordSets(zone.RecordSets)
+
+	return &zone, nil
+}
+
+func parseRecord(recordType RecordType, data []token, origin string) (*Record, error) {
+	expected := map[RecordType]int{
+		RecordTypeA:     1,
+		RecordTypeAAAA:  1,
+		RecordTypeCAA:   3,
+		RecordTypeCNAME: 1,
+		RecordTypeMX:    2,
+		RecordTypeNS:    1,
+		RecordTypePTR:   1,
+		RecordTypeSRV:   4,
+	}
+
+	if count, ok := expected[recordType]; ok && len(data) != count {
+		return nil, fmt.Errorf("%s records expect %d fields but got %d", recordType, count, len(data))
+	}
+
+	switch recordType {
+	case RecordTypeA:
+		ip := net.ParseIP(data[0].Value)
+		if ip == nil || ip.To4() == nil {
+			return nil, fmt.Errorf("%q is not a valid IPv4 address", data[0].Value)
+		}
+		return &Record{Value: ip.String()}, nil
+
+	case RecordTypeAAAA:
+		ip := net.ParseIP(data[0].Value)
+		if ip == nil || !strings.Contains(data[0].Value, ":") {
+			return nil, fmt.Errorf("%q is not a valid IPv6 address", data[0].Value)
+		}
+		return &Record{Value: ip.String()}, nil
+
+	case RecordTypeCNAME, RecordTypeNS, RecordTypePTR:
+		return &Record{Value: qualify(data[0].Value, origin)}, nil
+
+	case RecordTypeMX:
+		preference, err := parseUint(data[0].Value, math.MaxUint16, "preference")
+		if err != nil {
+			return nil, err
+		}
+		return &Record{Preference: preference, Exchange: qualify(data[1].Value, origin)}, nil
+
+	case RecordTypeSRV:
+		values := make([]int64, 0, 3)
+		for i, field := range []string{"priority", "weight", "port"} {
+			v, err := parseUint(data[i].Value, math.MaxUint16, field)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		return &Record{Priority: values[0], Weight: values[1], Port: values[2], Target: qualify(data[3].Value, origin)}, nil
+
+	case RecordTypeTXT:
+		if len(data) == 0 {
+			return nil, fmt.Errorf("TXT records expect at least one character string")
+		}
+		text := make([]string, 0, len(data))
+		for _, v := range data {
+			if len(v.Value) > maxCharacterStringLength {
+				return nil, fmt.Errorf("the TXT character string %q exceeds 255 characters, split it into multiple quoted strings", v.Value)
+			}
+			text = append(text, v.Value)
+		}
+		return &Record{Text: text}, nil
+
+	case RecordTypeCAA:
+		flags, err := parseUint(data[0].Value, math.MaxUint8, "flags")
+		if err != nil {
+			return nil, err
+		}
+		return &Record{Flags: flags, Tag: strings.ToLower(data[1].Value), Value: data[2].Value}, nil
+	}
+
+	return nil, fmt.Errorf("the record type %q is not supported", recordType)
+}
+
+func parseSOA(data []token, origin string) (*SOA, error) {
+	if len(data) != 7 {
+		return nil, fmt.Errorf("SOA records expect 7 fields but got %d", len(data))
+	}
+
+	values := make([]int64, 0, 5)
+	for i, field := range []string{"serial", "refresh", "retry", "expire", "minimum"} {
+		var v int64
+		var err error
+		if field == "serial" {
+			v, err = parseUint(data[2+i].Value, math.MaxUint32, field)
+		} else {
+			v, err = parseTTL(data[2+i].Value)
+		}
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+
+	return &SOA{
+		Host:    qualify(data[0].Value, origin),
+		Email:   qualify(data[1].Value, origin),
+		Serial:  values[0],
+		Refresh: values[1],
+		Retry:   values[2],
+		Expire:  values[3],
+		Minimum: values[4],
+	}, nil
+}
+
+// qualify returns the fully qualified form of a name, names which don't end with a dot are relative to origin
+func qualify(name, origin string) string {
+	if name == ApexName {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return strings.ToLower(name)
+	}
+	return strings.ToLower(name) + "." + origin
+}
+
+// relativeName returns the name relative to the origin of the zone
+func relativeName(fqdn, origin string) (string, error) {
+	if fqdn == origin {
+		return ApexName, nil
+	}
+	if !strings.HasSuffix(fqdn, "."+origin) {
+		return "", fmt.Errorf("the name %q is outside of the zone %q", fqdn, origin)
+	}
+	return strings.TrimSuffix(fqdn, "."+origin), nil
+}
+
+func parseUint(input string, max uint64, field string) (int64, error) {
+	v, err := strconv.ParseUint(input, 10, 64)
+	if err != nil || v > max {
+		return 0, fmt.Errorf("the %s %q must be a number between 0 and %d", field, input, max)
+	}
+	return int64(v), nil
+}
+
+// parseTTL parses a TTL in seconds, which may also use the units `w`, `d`, `h`, `m` and `s` - for example `1h30m`
+func parseTTL(input string) (int64, error) {
+	if input == "" {
+		return 0, fmt.Errorf("the TTL must not be empty")
+	}
+
+	if v, err := strconv.ParseUint(input, 10, 31); err == nil {
+		return int64(v), nil
+	}
+
+	units := map[byte]int64{
+		'w': 604800,
+		'd': 86400,
+		'h': 3600,
+		'm': 60,
+		's': 1,
+	}
+
+	total := int64(0)
+	number := ""
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if c >= '0' && c <= '9' {
+			number += string(c)
+			continue
+		}
+
+		multiplier, ok := units[c|0x20]
+		if !ok || number == "" {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		v, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		total += v * multiplier
+		number = ""
+	}
+
+	if number != "" || total > math.MaxInt32 {
+		return 0, fmt.Errorf("%q is not a valid TTL", input)
+	}
+
+	return total, nil
+}
+
+// tokenize splits the content into logical entries, removing comments and joining lines within parentheses
+func tokenize(content string) ([]entry, error) {
+	entries := make([]entry, 0)
+
+	line := 1
+	current := entry{Line: 1}
+	value := strings.Builder{}
+	hasValue := false
+	quoted := false
+	inQuote := false
+	depth := 0
+	startOfLine := true
+
+	flush := func() {
+		if hasValue {
+			current.Tokens = append(current.Tokens, token{Value: value.String(), Quoted: quoted})
+		}
+		value.Reset()
+		hasValue = false
+		quoted = false
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+
+		if startOfLine && depth == 0 && !inQuote {
+			current = entry{Line: line, BlankOwner: c == ' ' || c == '\t'}
+		}
+		startOfLine = false
+
+		if c == '\\' {
+			if i+1 >= len(content) {
+				return nil, fmt.Errorf("line %d: incomplete escape sequence", line)
+			}
+
+			if i+3 < len(content) && isDigit(content[i+1]) && isDigit(content[i+2]) && isDigit(content[i+3]) {
+				v, _ := strconv.Atoi(content[i+1 : i+4])
+				if v > 255 {
+					return nil, fmt.Errorf("line %d: invalid escape sequence `\\%s`", line, content[i+1:i+4])
+				}
+				value.WriteByte(byte(v))
+				i += 3
+			} else {
+				if content[i+1] == '\n' {
+					line++
+				}
+				value.WriteByte(content[i+1])
+				i++
+			}
+			hasValue = true
+			continue
+		}
+
+		if inQuote {
+			switch c {
+			case '"':
+				inQuote = false
+				flush()
+			case '\n':
+				return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+			default:
+				value.WriteByte(c)
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			flush()
+			inQuote = true
+			quoted = true
+			hasValue = true
+		case ';':
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+		case '(':
+			flush()
+			depth++
+		case ')':
+			flush()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+			depth--
+		case ' ', '\t', '\r':
+			flush()
+		case '\n':
+			flush()
+			if depth == 0 {
+				if len(current.Tokens) > 0 {
+					entries = append(entries, current)
+				}
+				current = entry{}
+			}
+			line++
+			startOfLine = true
+		default:
+			value.WriteByte(c)
+			hasValue = true
+		}
+	}
+
+	if inQuote {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+	}
+
+	flush()
+	if len(current.Tokens) > 0 {
+		entries = append(entries, current)
+	}
+
+	return entries, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
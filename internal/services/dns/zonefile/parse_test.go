@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	content := `
+$ORIGIN example.com.
+$TTL 1h
+@       IN  SOA ns1.example.com. hostmaster.example.com. (
+            2024010101 ; serial
+            3600       ; refresh
+            300        ; retry
+            2419200    ; expire
+            300 )      ; minimum
+        IN  NS   ns1.example.com.
+        IN  MX   10 mail
+        IN  MX   20 mail.backup.net.
+        IN  TXT  "v=spf1 include:spf.example.net -all"
+        IN  CAA  0 issue "letsencrypt.org"
+www  300 IN  A    192.0.2.1
+     IN 300  A    192.0.2.2
+     IN      A    192.0.2.1
+www     IN  AAAA 2001:DB8::1
+ftp         CNAME www
+_sip._tcp   SRV  10 60 5060 sip.example.com.
+sip         TXT  "part one" "part \"two\""
+1.2.0.192.in-addr.arpa. PTR host ; outside of the origin is an error below
+`
+
+	if _, err := Parse(content, "example.com", 3600); err == nil {
+		t.Fatalf("expected an error for a name outside of the zone but didn't get one")
+	}
+
+	content = content[:len(content)-len("1.2.0.192.in-addr.arpa. PTR host ; outside of the origin is an error below\n")]
+	zone, err := Parse(content, "example.com", 3600)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	expectedSOA := &SOA{
+		TTL:     3600,
+		Host:    "ns1.example.com.",
+		Email:   "hostmaster.example.com.",
+		Serial:  2024010101,
+		Refresh: 3600,
+		Retry:   300,
+		Expire:  2419200,
+		Minimum: 300,
+	}
+	if !reflect.DeepEqual(zone.SOA, expectedSOA) {
+		t.Fatalf("expected SOA %+v but got %+v", expectedSOA, zone.SOA)
+	}
+
+	expected := map[string][]string{
+		"@/CAA":         {`0 issue "letsencrypt.org"`},
+		"@/MX":          {"10 mail.example.com.", "20 mail.backup.net."},
+		"@/NS":          {"ns1.example.com."},
+		"@/TXT":         {`"v=spf1 include:spf.example.net -all"`},
+		"_sip._tcp/SRV": {"10 60 5060 sip.example.com."},
+		"ftp/CNAME":     {"www.example.com."},
+		"sip/TXT":       {`"part one" "part \"two\""`},
+		"www/A":         {"192.0.2.1", "192.0.2.2"},
+		"www/AAAA":      {"2001:db8::1"},
+	}
+
+	actual := make(map[string][]string)
+	keys := make([]string, 0)
+	for _, rs := range zone.RecordSets {
+		actual[rs.Key()] = rs.Values()
+		keys = append(keys, rs.Key())
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected record sets %+v but got %+v", expected, actual)
+	}
+
+	expectedKeys := []string{"@/CAA", "@/MX", "@/NS", "@/TXT", "_sip._tcp/SRV", "ftp/CNAME", "sip/TXT", "www/A", "www/AAAA"}
+	if !reflect.DeepEqual(keys, expectedKeys) {
+		t.Fatalf("expected record sets to be ordered %v but got %v", expectedKeys, keys)
+	}
+
+	for _, rs := range zone.RecordSets {
+		expectedTTL := int64(3600)
+		if rs.Key() == "www/A" {
+			expectedTTL = 300
+		}
+		if rs.TTL != expectedTTL {
+			t.Fatalf("expected the TTL of %q to be %d but got %d", rs.Key(), expectedTTL, rs.TTL)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	testcases := map[string]string{
+		"Unsupported Class":      "www CH A 192.0.2.1",
+		"Unsupported Type":       "www IN HINFO PC Linux",
+		"Unsupported Directive":  "$INCLUDE other.zone",
+		"Invalid IPv4 Address":   "www IN A 2001:db8::1",
+		"Invalid IPv6 Address":   "www IN AAAA 192.0.2.1",
+		"Invalid MX Preference":  "@ IN MX 70000 mail",
+		"Missing Owner":          " IN A 192.0.2.1",
+		"Multiple CNAME Records": "www CNAME a\nwww CNAME b",
+		"CNAME With Other Types": "www CNAME a\nwww A 192.0.2.1",
+		"Unterminated Quote":     "@ TXT \"unterminated\n",
+		"Unbalanced Parentheses": "@ SOA ns1 hostmaster ( 1 2 3 4 5",
+	}
+
+	for name, content := range testcases {
+		if _, err := Parse(content, "example.com.", 3600); err == nil {
+			t.Fatalf("%s: expected an error but didn't get one", name)
+		}
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	testcases := map[string]int64{
+		"0":     0,
+		"300":   300,
+		"1h":    3600,
+		"1h30m": 5400,
+		"1D":    86400,
+		"2w":    1209600,
+	}
+
+	for input, expected := range testcases {
+		actual, err := parseTTL(input)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", input, err)
+		}
+		if actual != expected {
+			t.Fatalf("expected %q to be %d but got %d", input, expected, actual)
+		}
+	}
+
+	for _, input := range []string{"", "h", "1x", "1h30", "IN"} {
+		if _, err := parseTTL(input); err == nil {
+			t.Fatalf("expected an error for %q but didn't get one", input)
+		}
+	}
+}
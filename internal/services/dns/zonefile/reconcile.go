@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+// Reconciliation describes the changes required to make the record sets of a zone match a zone file
+type Reconciliation struct {
+	// Upserts are the record sets which need to be created or replaced
+	Upserts []RecordSet
+
+	// Deletions are the record sets which need to be deleted
+	Deletions []RecordSet
+}
+
+// Reconcile compares the record sets declared in a zone file with those which exist in the zone. When authoritative
+// every record set in the zone which isn't declared is deleted, otherwise only the previously declared record sets
+// which are no longer declared are deleted. Record sets which are managed by Azure are always ignored.
+func Reconcile(declared, existing, previouslyDeclared []RecordSet, authoritative bool) Reconciliation {
+	result := Reconciliation{
+		Upserts:   make([]RecordSet, 0),
+		Deletions: make([]RecordSet, 0),
+	}
+
+	existingByKey := make(map[string]RecordSet)
+	for _, rs := range existing {
+		existingByKey[rs.Key()] = rs
+	}
+
+	declaredKeys := make(map[string]struct{})
+	for _, rs := range declared {
+		if rs.IsManagedByAzure() {
+			continue
+		}
+		declaredKeys[rs.Key()] = struct{}{}
+
+		if current, ok := existingByKey[rs.Key()]; !ok || !current.Equal(rs) {
+			result.Upserts = append(result.Upserts, rs)
+		}
+	}
+
+	candidates := previouslyDeclared
+	if authoritative {
+		candidates = existing
+	}
+
+	for _, rs := range candidates {
+		if rs.IsManagedByAzure() {
+			continue
+		}
+		if _, ok := declaredKeys[rs.Key()]; ok {
+			continue
+		}
+		if current, ok := existingByKey[rs.Key()]; ok {
+			result.Deletions = append(result.Deletions, current)
+			// avoid deleting the same record set twice when it was declared more than once
+			delete(existingByKey, rs.Key())
+		}
+	}
+
+	return result
+}
+
+// Managed returns the record sets in the zone which are managed by a zone file, sorted by name and type. When
+// authoritative this is every record set in the zone, otherwise only the record sets declared in the zone file.
+// Record sets which are managed by Azure are never included.
+func Managed(declared, existing []RecordSet, authoritative bool) []RecordSet {
+	declaredKeys := make(map[string]struct{})
+	for _, rs := range declared {
+		declaredKeys[rs.Key()] = struct{}{}
+	}
+
+	result := make([]RecordSet, 0)
+	for _, rs := range existing {
+		if rs.IsManagedByAzure() {
+			continue
+		}
+		if _, ok := declaredKeys[rs.Key()]; ok || authoritative {
+			result = append(result, rs)
+		}
+	}
+
+	SortRecordSets(result)
+
+	return result
+}
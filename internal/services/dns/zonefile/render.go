@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxCharacterStringLength is the maximum length of a single character string within a TXT record
+const maxCharacterStringLength = 255
+
+// Render returns the zone in RFC 1035 presentation format. The output is deterministic: the SOA record is rendered
+// first, followed by the record sets sorted by name (with the apex first) and type, with the records in each record
+// set sorted by their value. Every record is rendered with an explicit TTL and class, and names are rendered relative
+// to the origin. Record sets without any records, such as alias record sets, can't be represented and are omitted.
+func Render(zone Zone) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("$ORIGIN %s\n", zone.Origin))
+
+	if soa := zone.SOA; soa != nil {
+		sb.WriteString(fmt.Sprintf("%s\t%d\tIN\t%s\t%s %s %d %d %d %d %d\n", ApexName, soa.TTL, RecordTypeSOA, soa.Host, soa.Email, soa.Serial, soa.Refresh, soa.Retry, soa.Expire, soa.Minimum))
+	}
+
+	recordSets := make([]RecordSet, 0, len(zone.RecordSets))
+	for _, rs := range zone.RecordSets {
+		if rs.Type == RecordTypeSOA || len(rs.Records) == 0 {
+			continue
+		}
+		recordSets = append(recordSets, rs)
+	}
+	SortRecordSets(recordSets)
+
+	for _, rs := range recordSets {
+		records := make([]Record, 0, len(rs.Records))
+		for _, record := range rs.Records {
+			if rs.Type == RecordTypeTXT {
+				record.Text = splitCharacterStrings(record.Text)
+			}
+			records = append(records, record)
+		}
+
+		for _, value := range (RecordSet{Type: rs.Type, Records: records}).Values() {
+			sb.WriteString(fmt.Sprintf("%s\t%d\tIN\t%s\t%s\n", rs.Name, rs.TTL, rs.Type, value))
+		}
+	}
+
+	return sb.String()
+}
+
+// splitCharacterStrings splits values which exceed the maximum length of a character string, which Azure allows
+// within a single TXT value, into multiple character strings
+func splitCharacterStrings(input []string) []string {
+	result := make([]string, 0, len(input))
+	for _, v := range input {
+		for len(v) > maxCharacterStringLength {
+			result = append(result, v[:maxCharacterStringLength])
+			v = v[maxCharacterStringLength:]
+		}
+		result = append(result, v)
+	}
+	return result
+}
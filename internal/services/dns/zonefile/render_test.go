@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	zone := Zone{
+		Origin: "example.com.",
+		SOA: &SOA{
+			TTL:     3600,
+			Host:    "ns1-01.azure-dns.com.",
+			Email:   "azuredns-hostmaster.microsoft.com.",
+			Serial:  1,
+			Refresh: 3600,
+			Retry:   300,
+			Expire:  2419200,
+			Minimum: 300,
+		},
+		RecordSets: []RecordSet{
+			{Name: "www", Type: RecordTypeA, TTL: 300, Records: []Record{{Value: "192.0.2.2"}, {Value: "192.0.2.1"}}},
+			{Name: "alias", Type: RecordTypeA, TTL: 300, Records: []Record{}},
+			{Name: "@", Type: RecordTypeMX, TTL: 3600, Records: []Record{{Preference: 20, Exchange: "mail.backup.net."}, {Preference: 10, Exchange: "mail.example.com."}}},
+			{Name: "@", Type: RecordTypeNS, TTL: 172800, Records: []Record{{Value: "ns1-01.azure-dns.com."}}},
+			{Name: "_sip._tcp", Type: RecordTypeSRV, TTL: 3600, Records: []Record{{Priority: 10, Weight: 60, Port: 5060, Target: "sip.example.com."}}},
+			{Name: "sip", Type: RecordTypeTXT, TTL: 3600, Records: []Record{{Text: []string{strings.Repeat("a", 300)}}}},
+			{Name: "@", Type: RecordTypeCAA, TTL: 3600, Records: []Record{{Flags: 0, Tag: "issue", Value: "letsencrypt.org"}}},
+		},
+	}
+
+	expected := strings.Join([]string{
+		"$ORIGIN example.com.",
+		"@\t3600\tIN\tSOA\tns1-01.azure-dns.com. azuredns-hostmaster.microsoft.com. 1 3600 300 2419200 300",
+		"@\t3600\tIN\tCAA\t0 issue \"letsencrypt.org\"",
+		"@\t3600\tIN\tMX\t10 mail.example.com.",
+		"@\t3600\tIN\tMX\t20 mail.backup.net.",
+		"@\t172800\tIN\tNS\tns1-01.azure-dns.com.",
+		"_sip._tcp\t3600\tIN\tSRV\t10 60 5060 sip.example.com.",
+		"sip\t3600\tIN\tTXT\t\"" + strings.Repeat("a", 255) + "\" \"" + strings.Repeat("a", 45) + "\"",
+		"www\t300\tIN\tA\t192.0.2.1",
+		"www\t300\tIN\tA\t192.0.2.2",
+		"",
+	}, "\n")
+
+	actual := Render(zone)
+	if actual != expected {
+		t.Fatalf("expected:\n%s\nbut got:\n%s", expected, actual)
+	}
+
+	// the rendered zone file can be parsed back into the same record sets
+	parsed, err := Parse(actual, "example.com", 3600)
+	if err != nil {
+		t.Fatalf("parsing the rendered zone: %+v", err)
+	}
+
+	if parsed.SOA == nil || parsed.SOA.Host != zone.SOA.Host || parsed.SOA.Serial != zone.SOA.Serial {
+		t.Fatalf("expected the SOA record %+v but got %+v", zone.SOA, parsed.SOA)
+	}
+
+	if Render(*parsed) != expected {
+		t.Fatalf("expected rendering the parsed zone to match:\n%s\nbut got:\n%s", expected, Render(*parsed))
+	}
+
+	keys := make([]string, 0)
+	for _, rs := range parsed.RecordSets {
+		keys = append(keys, rs.Key())
+	}
+	expectedKeys := []string{"@/CAA", "@/MX", "@/NS", "_sip._tcp/SRV", "sip/TXT", "www/A"}
+	if !reflect.DeepEqual(keys, expectedKeys) {
+		t.Fatalf("expected record sets %v but got %v", expectedKeys, keys)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package zonefile
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+type RecordType string
+
+const (
+	RecordTypeA     RecordType = "A"
+	RecordTypeAAAA  RecordType = "AAAA"
+	RecordTypeCAA   RecordType = "CAA"
+	RecordTypeCNAME RecordType = "CNAME"
+	RecordTypeMX    RecordType = "MX"
+	RecordTypeNS    RecordType = "NS"
+	RecordTypePTR   RecordType = "PTR"
+	RecordTypeSOA   RecordType = "SOA"
+	RecordTypeSRV   RecordType = "SRV"
+	RecordTypeTXT   RecordType = "TXT"
+)
+
+// ApexName is the relative name of the record sets at the apex of a zone
+const ApexName = "@"
+
+// Zone is the content of a zone file, with the record sets keyed by their relative name and type
+type Zone struct {
+	// Origin is the fully qualified name of the zone, including the trailing dot
+	Origin     string
+	SOA        *SOA
+	RecordSets []RecordSet
+}
+
+type SOA struct {
+	TTL     int64
+	Host    string
+	Email   string
+	Serial  int64
+	Refresh int64
+	Retry   int64
+	Expire  int64
+	Minimum int64
+}
+
+type RecordSet struct {
+	// Name is the lower-cased name of the record set relative to the origin of the zone, or `@` for the apex
+	Name    string
+	Type    RecordType
+	TTL     int64
+	Records []Record
+}
+
+// Record holds the data of a single record, which fields are used depends on the type of the record set
+type Record struct {
+	// Value is the address of A and AAAA records, the fully qualified domain name of CNAME, NS and PTR records and the
+	// value of CAA records
+	Value string
+
+	// Preference and Exchange are used by MX records
+	Preference int64
+	Exchange   string
+
+	// Priority, Weight, Port and Target are used by SRV records
+	Priority int64
+	Weight   int64
+	Port     int64
+	Target   string
+
+	// Text holds the character strings of TXT records
+	Text []string
+
+	// Flags and Tag are used by CAA records, along with Value
+	Flags int64
+	Tag   string
+}
+
+// String returns the record data in the presentation format of a zone file
+func (r Record) String(recordType RecordType) string {
+	switch recordType {
+	case RecordTypeMX:
+		return fmt.Sprintf("%d %s", r.Preference, r.Exchange)
+	case RecordTypeSRV:
+		return fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, r.Target)
+	case RecordTypeTXT:
+		values := make([]string, 0, len(r.Text))
+		for _, v := range r.Text {
+			values = append(values, Quote(v))
+		}
+		return strings.Join(values, " ")
+	case RecordTypeCAA:
+		return fmt.Sprintf("%d %s %s", r.Flags, r.Tag, Quote(r.Value))
+	}
+
+	return r.Value
+}
+
+// Values returns the data of each record in the record set in presentation format, sorted so that record sets can be
+// compared regardless of the order the records were specified or returned in
+func (rs RecordSet) Values() []string {
+	result := make([]string, 0, len(rs.Records))
+	for _, record := range rs.Records {
+		result = append(result, record.String(rs.Type))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Key uniquely identifies a record set within a zone
+func (rs RecordSet) Key() string {
+	return fmt.Sprintf("%s/%s", rs.Name, rs.Type)
+}
+
+// Equal returns whether the TTL and records of two record sets with the same key match
+func (rs RecordSet) Equal(other RecordSet) bool {
+	if rs.TTL != other.TTL {
+		return false
+	}
+
+	values := rs.Values()
+	otherValues := other.Values()
+	if len(values) != len(otherValues) {
+		return false
+	}
+	for i := range values {
+		if values[i] != otherValues[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// IsManagedByAzure returns whether the record set is maintained by Azure, which is the case for the SOA record and
+// the NS records at the apex of the zone
+func (rs RecordSet) IsManagedByAzure() bool {
+	return rs.Type == RecordTypeSOA || (rs.Type == RecordTypeNS && rs.Name == ApexName)
+}
+
+// SortRecordSets sorts record sets by name, with the apex first, and then by type
+func SortRecordSets(recordSets []RecordSet) {
+	sort.SliceStable(recordSets, func(i, j int) bool {
+		if recordSets[i].Name != recordSets[j].Name {
+			if recordSets[i].Name == ApexName || recordSets[j].Name == ApexName {
+				return recordSets[i].Name == ApexName
+			}
+			return recordSets[i].Name < recordSets[j].Name
+		}
+		return recordSets[i].Type < recordSets[j].Type
+	})
+}
+
+// Fqdn returns the lower-cased fully qualified form of a domain name, including the trailing dot
+func Fqdn(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".") + "."
+}
+
+// Quote returns the value as a quoted character string, escaping quotes and backslashes
+func Quote(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	return `"` + replacer.Replace(value) + `"`
+}
+
+// NormalizeAddress returns the canonical form of an IPv4 or IPv6 address, or the input when it can't be parsed
+func NormalizeAddress(address string) string {
+	if ip := net.ParseIP(address); ip != nil {
+		return ip.String()
+	}
+	return address
+}
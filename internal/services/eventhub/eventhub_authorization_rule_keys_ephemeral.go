@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package eventhub
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/authorizationruleseventhubs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/authorizationrulesnamespaces"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.EphemeralResource = &EventHubAuthorizationRuleKeysEphemeralResource{}
+
+func NewEventHubAuthorizationRuleKeysEphemeralResource() ephemeral.EphemeralResource {
+	return &EventHubAuthorizationRuleKeysEphemeralResource{}
+}
+
+type EventHubAuthorizationRuleKeysEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type EventHubAuthorizationRuleKeysEphemeralResourceModel struct {
+	AuthorizationRuleID            types.String `tfsdk:"authorization_rule_id"`
+	PrimaryKey                     types.String `tfsdk:"primary_key"`
+	SecondaryKey                   types.String `tfsdk:"secondary_key"`
+	PrimaryConnectionString        types.String `tfsdk:"primary_connection_string"`
+	SecondaryConnectionString      types.String `tfsdk:"secondary_connection_string"`
+	PrimaryConnectionStringAlias   types.String `tfsdk:"primary_connection_string_alias"`
+	SecondaryConnectionStringAlias types.String `tfsdk:"secondary_connection_string_alias"`
+}
+
+func (e *EventHubAuthorizationRuleKeysEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_eventhub_authorization_rule_keys"
+}
+
+func (e *EventHubAuthorizationRuleKeysEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *EventHubAuthorizationRuleKeysEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_rule_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.Any(
+							authorizationrulesnamespaces.ValidateAuthorizationRuleID,
+							authorizationruleseventhubs.ValidateEventhubAuthorizationRuleID,
+						),
+					},
+				},
+			},
+
+			"primary_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string_alias": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string_alias": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *EventHubAuthorizationRuleKeysEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data EventHubAuthorizationRuleKeysEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	// the Authorization Rule can belong to either the Namespace or an Event Hub - which use a different API
+	rawId := data.AuthorizationRuleID.ValueString()
+	if id, err := authorizationrulesnamespaces.ParseAuthorizationRuleID(rawId); err == nil {
+		keysResp, err := e.Client.Eventhub.NamespaceAuthorizationRulesClient.NamespacesListKeys(ctx, *id)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing keys for %s", id), err)
+			return
+		}
+		if model := keysResp.Model; model != nil {
+			data.setKeys(model.PrimaryKey, model.SecondaryKey, model.PrimaryConnectionString, model.SecondaryConnectionString, model.AliasPrimaryConnectionString, model.AliasSecondaryConnectionString)
+		}
+	} else {
+		id, err := authorizationruleseventhubs.ParseEventhubAuthorizationRuleID(rawId)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, "", err)
+			return
+		}
+		keysResp, err := e.Client.Eventhub.EventHubAuthorizationRulesClient.EventHubsListKeys(ctx, *id)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing keys for %s", id), err)
+			return
+		}
+		if model := keysResp.Model; model != nil {
+			data.setKeys(model.PrimaryKey, model.SecondaryKey, model.PrimaryConnectionString, model.SecondaryConnectionString, model.AliasPrimaryConnectionString, model.AliasSecondaryConnectionString)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (m *EventHubAuthorizationRuleKeysEphemeralResourceModel) setKeys(primaryKey, secondaryKey, primaryConnectionString, secondaryConnectionString, primaryConnectionStringAlias, secondaryConnectionStringAlias *string) {
+	m.PrimaryKey = types.StringValue(pointer.From(primaryKey))
+	m.SecondaryKey = types.StringValue(pointer.From(secondaryKey))
+	m.PrimaryConnectionString = types.StringValue(pointer.From(primaryConnectionString))
+	m.SecondaryConnectionString = types.StringValue(pointer.From(secondaryConnectionString))
+	m.PrimaryConnectionStringAlias = types.StringValue(pointer.From(primaryConnectionStringAlias))
+	m.SecondaryConnectionStringAlias = types.StringValue(pointer.From(secondaryConnectionStringAlias))
+}
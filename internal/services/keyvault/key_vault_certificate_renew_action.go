@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/convert"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/certificates"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KeyVaultCertificateRenewAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KeyVaultCertificateRenewAction{}
+
+func newKeyVaultCertificateRenewAction() action.Action {
+	return &KeyVaultCertificateRenewAction{}
+}
+
+type KeyVaultCertificateRenewActionModel struct {
+	Name               types.String                          `tfsdk:"name"`
+	KeyVaultId         types.String                          `tfsdk:"key_vault_id"`
+	SignedCertificates typehelpers.ListValueOf[types.String] `tfsdk:"signed_certificates"`
+	Timeout            types.String                          `tfsdk:"timeout"`
+}
+
+func (k *KeyVaultCertificateRenewAction) Schema(ctx context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the Key Vault Certificate to renew.",
+				MarkdownDescription: "The name of the Key Vault Certificate to renew.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: keyvault.ValidateNestedItemName,
+					},
+				},
+			},
+
+			"key_vault_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Key Vault containing the Certificate.",
+				MarkdownDescription: "The ID of the Key Vault containing the Certificate.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKeyVaultID,
+					},
+				},
+			},
+
+			"signed_certificates": schema.ListAttribute{
+				CustomType:          typehelpers.NewListTypeOf[types.String](ctx),
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "A list of base64 encoded certificates, signed by an external Certificate Authority, to merge with the pending Certificate Signing Request. When not specified a new version of the Certificate is requested using the Certificate's policy.",
+				MarkdownDescription: "A list of base64 encoded certificates, signed by an external Certificate Authority, to merge with the pending Certificate Signing Request. When not specified a new version of the Certificate is requested using the Certificate's policy.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(
+						typehelpers.WrappedStringValidator{
+							Func: validation.StringIsBase64,
+						},
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the renewal to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the renewal to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (k *KeyVaultCertificateRenewAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_certificate_renew"
+}
+
+func (k *KeyVaultCertificateRenewAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	keyVaultsClient := k.Client.KeyVault
+
+	model := KeyVaultCertificateRenewActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	keyVaultId, err := commonids.ParseKeyVaultID(model.KeyVaultId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("looking up base uri for Certificate %q in %s: %+v", model.Name.ValueString(), keyVaultId, err))
+		return
+	}
+
+	client := keyVaultsClient.DataPlaneKeyVaultClient.Certificates.Clone(*keyVaultBaseUri)
+	certificateId := certificates.NewCertificateID(*keyVaultBaseUri, model.Name.ValueString())
+
+	if !model.SignedCertificates.IsNull() {
+		signedCertificates := make([]string, 0)
+		convert.Expand(ctx, model.SignedCertificates, &signedCertificates, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("merging the signed certificates with the pending request for Certificate %q in %s", certificateId.CertificateName, keyVaultId),
+		})
+
+		resp, err := client.MergeCertificate(ctx, certificateId, certificates.CertificateMergeParameters{
+			X5c: signedCertificates,
+		})
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("merging Certificate %q in %s: %+v", certificateId.CertificateName, keyVaultId, err))
+			return
+		}
+
+		version := ""
+		if bundle := resp.Model; bundle != nil {
+			id, err := keyvault.ParseNestedItemID(pointer.From(bundle.Id), keyvault.VersionTypeVersioned, keyvault.NestedItemTypeCertificate)
+			if err != nil {
+				sdk.SetResponseErrorDiagnostic(response, "running action", err)
+				return
+			}
+			version = id.Version
+		}
+
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Certificate %q merged, the new version is %q", certificateId.CertificateName, version),
+		})
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("renewing Certificate %q in %s", certificateId.CertificateName, keyVaultId),
+	})
+
+	// omitting the policy creates a new version of the certificate using the existing policy
+	if _, err := client.CreateCertificate(ctx, certificateId, certificates.CertificateCreateParameters{}); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("renewing Certificate %q in %s: %+v", certificateId.CertificateName, keyVaultId, err))
+		return
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(response, "running action", "internal-error: context had no deadline")
+		return
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Provisioning"},
+		Target:     []string{"Ready", "PendingMerge"},
+		Refresh:    keyVaultCertificateOperationRefreshFunc(ctx, client, certificateId),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("waiting for Certificate %q in %s to be renewed: %+v", certificateId.CertificateName, keyVaultId, err))
+		return
+	}
+
+	// certificates issued by an external Certificate Authority remain pending until the signed certificate is merged
+	if operation, ok := result.(*certificates.CertificateOperation); ok && operation != nil && strings.EqualFold(pointer.From(operation.Status), "inProgress") {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Certificate %q is waiting for the following Certificate Signing Request to be signed and merged:\n%s", certificateId.CertificateName, pointer.From(operation.Csr)),
+		})
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Certificate %q renewed", certificateId.CertificateName),
+	})
+}
+
+func (k *KeyVaultCertificateRenewAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
+
+func keyVaultCertificateOperationRefreshFunc(ctx context.Context, client *certificates.CertificatesClient, id certificates.CertificateId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetCertificateOperation(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving the pending operation for Certificate %q: %+v", id.CertificateName, err)
+		}
+		operation := resp.Model
+		if operation == nil || operation.Status == nil {
+			return nil, "", fmt.Errorf("missing status in certificate operation")
+		}
+
+		switch {
+		case strings.EqualFold(*operation.Status, "inProgress"):
+			if issuer := operation.Issuer; issuer != nil && strings.EqualFold(pointer.From(issuer.Name), "unknown") {
+				return operation, "PendingMerge", nil
+			}
+			return operation, "Provisioning", nil
+
+		case strings.EqualFold(*operation.Status, "completed"):
+			return operation, "Ready", nil
+		}
+
+		message := pointer.From(operation.StatusDetails)
+		if operation.Error != nil {
+			message = pointer.From(operation.Error.Message)
+		}
+		return nil, "", fmt.Errorf("certificate operation failed in state %q: %s", *operation.Status, message)
+	}
+}
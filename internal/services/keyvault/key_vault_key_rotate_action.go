@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/keys"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type KeyVaultKeyRotateAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KeyVaultKeyRotateAction{}
+
+func newKeyVaultKeyRotateAction() action.Action {
+	return &KeyVaultKeyRotateAction{}
+}
+
+type KeyVaultKeyRotateActionModel struct {
+	Name       types.String `tfsdk:"name"`
+	KeyVaultId types.String `tfsdk:"key_vault_id"`
+	Timeout    types.String `tfsdk:"timeout"`
+}
+
+func (k *KeyVaultKeyRotateAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the Key Vault Key to rotate.",
+				MarkdownDescription: "The name of the Key Vault Key to rotate.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: keyvault.ValidateNestedItemName,
+					},
+				},
+			},
+
+			"key_vault_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Key Vault containing the Key.",
+				MarkdownDescription: "The ID of the Key Vault containing the Key.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKeyVaultID,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the rotation to complete. Defaults to `5m`.",
+				MarkdownDescription: "Timeout duration for the rotation to complete. Defaults to `5m`.",
+			},
+		},
+	}
+}
+
+func (k *KeyVaultKeyRotateAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_key_rotate"
+}
+
+func (k *KeyVaultKeyRotateAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	keyVaultsClient := k.Client.KeyVault
+
+	model := KeyVaultKeyRotateActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 5 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	keyVaultId, err := commonids.ParseKeyVaultID(model.KeyVaultId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("looking up base uri for Key %q in %s: %+v", model.Name.ValueString(), keyVaultId, err))
+		return
+	}
+
+	client := keyVaultsClient.DataPlaneKeyVaultClient.Keys.Clone(*keyVaultBaseUri)
+	keyId := keys.NewKeyID(*keyVaultBaseUri, model.Name.ValueString())
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("rotating Key %q in %s", keyId.KeyName, keyVaultId),
+	})
+
+	// the new version is generated according to the rotation policy of the key
+	resp, err := client.RotateKey(ctx, keyId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("rotating Key %q in %s: %+v", keyId.KeyName, keyVaultId, err))
+		return
+	}
+
+	version := ""
+	if bundle := resp.Model; bundle != nil && bundle.Key != nil {
+		id, err := keyvault.ParseNestedItemID(pointer.From(bundle.Key.Kid), keyvault.VersionTypeVersioned, keyvault.NestedItemTypeKey)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", err)
+			return
+		}
+		version = id.Version
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Key %q rotated, the new version is %q", keyId.KeyName, version),
+	})
+}
+
+func (k *KeyVaultKeyRotateAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
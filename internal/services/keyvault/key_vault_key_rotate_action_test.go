@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KeyVaultKeyRotateAction struct{}
+
+func TestAccKeyVaultKeyRotateAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key_rotate", "test")
+	a := KeyVaultKeyRotateAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *KeyVaultKeyRotateAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_key_vault_key.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_key_vault_key_rotate.test]
+    }
+  }
+}
+
+action "azurerm_key_vault_key_rotate" "test" {
+  config {
+    name         = azurerm_key_vault_key.test.name
+    key_vault_id = azurerm_key_vault.test.id
+  }
+}
+`, KeyVaultKeyResource{}.rotationPolicyBasic(data))
+}
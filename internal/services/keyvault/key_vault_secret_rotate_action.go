@@ -0,0 +1,215 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/secrets"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type KeyVaultSecretRotateAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KeyVaultSecretRotateAction{}
+
+func newKeyVaultSecretRotateAction() action.Action {
+	return &KeyVaultSecretRotateAction{}
+}
+
+type KeyVaultSecretRotateActionModel struct {
+	Name           types.String `tfsdk:"name"`
+	KeyVaultId     types.String `tfsdk:"key_vault_id"`
+	Value          types.String `tfsdk:"value"`
+	ContentType    types.String `tfsdk:"content_type"`
+	NotBeforeDate  types.String `tfsdk:"not_before_date"`
+	ExpirationDate types.String `tfsdk:"expiration_date"`
+	Timeout        types.String `tfsdk:"timeout"`
+}
+
+func (k *KeyVaultSecretRotateAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of an existing Key Vault Secret to create a new version of. The tags of the current version are carried over to the new version.",
+				MarkdownDescription: "The name of an existing Key Vault Secret to create a new version of. The tags of the current version are carried over to the new version.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: keyvault.ValidateNestedItemName,
+					},
+				},
+			},
+
+			"key_vault_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Key Vault containing the Secret.",
+				MarkdownDescription: "The ID of the Key Vault containing the Secret.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKeyVaultID,
+					},
+				},
+			},
+
+			"value": schema.StringAttribute{
+				Required:            true,
+				WriteOnly:           true,
+				Description:         "The value of the new version of the Secret.",
+				MarkdownDescription: "The value of the new version of the Secret.",
+			},
+
+			"content_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The content type of the new version of the Secret. Defaults to the content type of the current version.",
+				MarkdownDescription: "The content type of the new version of the Secret. Defaults to the content type of the current version.",
+			},
+
+			"not_before_date": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The earliest date at which the new version of the Secret can be used, as an RFC3339 timestamp.",
+				MarkdownDescription: "The earliest date at which the new version of the Secret can be used, as an RFC3339 timestamp.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsRFC3339Time,
+					},
+				},
+			},
+
+			"expiration_date": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The expiration date of the new version of the Secret, as an RFC3339 timestamp.",
+				MarkdownDescription: "The expiration date of the new version of the Secret, as an RFC3339 timestamp.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsRFC3339Time,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the new version to be created. Defaults to `5m`.",
+				MarkdownDescription: "Timeout duration for the new version to be created. Defaults to `5m`.",
+			},
+		},
+	}
+}
+
+func (k *KeyVaultSecretRotateAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_secret_rotate"
+}
+
+func (k *KeyVaultSecretRotateAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	keyVaultsClient := k.Client.KeyVault
+
+	model := KeyVaultSecretRotateActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 5 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	keyVaultId, err := commonids.ParseKeyVaultID(model.KeyVaultId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("looking up base uri for Secret %q in %s: %+v", model.Name.ValueString(), keyVaultId, err))
+		return
+	}
+
+	client := keyVaultsClient.DataPlaneKeyVaultClient.Secrets.Clone(*keyVaultBaseUri)
+	secretId := secrets.NewSecretID(*keyVaultBaseUri, model.Name.ValueString())
+
+	// the tags and content type apply to each version rather than the Secret, so these are carried over from the current
+	// version to avoid them being removed from a Secret managed by `azurerm_key_vault_secret`
+	existing, err := client.GetSecret(ctx, secrets.NewSecretversionID(*keyVaultBaseUri, secretId.SecretName, ""))
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("retrieving the current version of Secret %q in %s: %+v", secretId.SecretName, keyVaultId, err))
+		return
+	}
+
+	parameters := secrets.SecretSetParameters{
+		Value:      model.Value.ValueString(),
+		Attributes: &secrets.SecretAttributes{},
+	}
+
+	if current := existing.Model; current != nil {
+		parameters.ContentType = current.ContentType
+		parameters.Tags = current.Tags
+	}
+
+	if v := model.ContentType.ValueString(); v != "" {
+		parameters.ContentType = pointer.To(v)
+	}
+
+	if v := model.NotBeforeDate.ValueString(); v != "" {
+		notBeforeDate, _ := time.Parse(time.RFC3339, v) // validated by schema
+		parameters.Attributes.Nbf = pointer.To(notBeforeDate.Unix())
+	}
+
+	if v := model.ExpirationDate.ValueString(); v != "" {
+		expirationDate, _ := time.Parse(time.RFC3339, v) // validated by schema
+		parameters.Attributes.Exp = pointer.To(expirationDate.Unix())
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("creating a new version of Secret %q in %s", secretId.SecretName, keyVaultId),
+	})
+
+	resp, err := client.SetSecret(ctx, secretId, parameters)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("creating a new version of Secret %q in %s: %+v", secretId.SecretName, keyVaultId, err))
+		return
+	}
+
+	version := ""
+	if bundle := resp.Model; bundle != nil {
+		id, err := keyvault.ParseNestedItemID(pointer.From(bundle.Id), keyvault.VersionTypeVersioned, keyvault.NestedItemTypeSecret)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", err)
+			return
+		}
+		version = id.Version
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Secret %q rotated, the new version is %q", secretId.SecretName, version),
+	})
+}
+
+func (k *KeyVaultSecretRotateAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
@@ -0,0 +1,333 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/certificates"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/deletedcertificates"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/deletedkeys"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/deletedsecrets"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/keys"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/secrets"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const (
+	keyVaultSoftDeletedItemTypeCertificate = "certificate"
+	keyVaultSoftDeletedItemTypeKey         = "key"
+	keyVaultSoftDeletedItemTypeSecret      = "secret"
+
+	keyVaultSoftDeletedItemOperationPurge   = "purge"
+	keyVaultSoftDeletedItemOperationRecover = "recover"
+)
+
+type KeyVaultSoftDeletedItemAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KeyVaultSoftDeletedItemAction{}
+
+func newKeyVaultSoftDeletedItemAction() action.Action {
+	return &KeyVaultSoftDeletedItemAction{}
+}
+
+type KeyVaultSoftDeletedItemActionModel struct {
+	Name       types.String `tfsdk:"name"`
+	KeyVaultId types.String `tfsdk:"key_vault_id"`
+	ItemType   types.String `tfsdk:"item_type"`
+	Operation  types.String `tfsdk:"operation"`
+	Timeout    types.String `tfsdk:"timeout"`
+}
+
+// keyVaultSoftDeletedItemClient wraps the data plane operations required to purge or recover a soft-deleted item, so
+// that certificates, keys and secrets can be handled in the same way
+type keyVaultSoftDeletedItemClient struct {
+	purge      func(ctx context.Context) (*http.Response, error)
+	getDeleted func(ctx context.Context) (*http.Response, error)
+	recover    func(ctx context.Context) (*http.Response, error)
+	get        func(ctx context.Context) (*http.Response, error)
+}
+
+func (k *KeyVaultSoftDeletedItemAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the soft-deleted item.",
+				MarkdownDescription: "The name of the soft-deleted item.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: keyvault.ValidateNestedItemName,
+					},
+				},
+			},
+
+			"key_vault_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Key Vault the item was deleted from.",
+				MarkdownDescription: "The ID of the Key Vault the item was deleted from.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKeyVaultID,
+					},
+				},
+			},
+
+			"item_type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The type of the soft-deleted item. Possible values are `certificate`, `key` and `secret`.",
+				MarkdownDescription: "The type of the soft-deleted item. Possible values are `certificate`, `key` and `secret`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						keyVaultSoftDeletedItemTypeCertificate,
+						keyVaultSoftDeletedItemTypeKey,
+						keyVaultSoftDeletedItemTypeSecret,
+					),
+				},
+			},
+
+			"operation": schema.StringAttribute{
+				Required:            true,
+				Description:         "The operation to perform on the soft-deleted item. Possible values are `purge` and `recover`.",
+				MarkdownDescription: "The operation to perform on the soft-deleted item. Possible values are `purge` and `recover`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						keyVaultSoftDeletedItemOperationPurge,
+						keyVaultSoftDeletedItemOperationRecover,
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the operation to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the operation to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (k *KeyVaultSoftDeletedItemAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_soft_deleted_item"
+}
+
+func (k *KeyVaultSoftDeletedItemAction) Invoke(ctx context.Context, request action.InvokeRequest, resp *action.InvokeResponse) {
+	keyVaultsClient := k.Client.KeyVault
+
+	model := KeyVaultSoftDeletedItemActionModel{}
+
+	resp.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	keyVaultId, err := commonids.ParseKeyVaultID(model.KeyVaultId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "parsing id", err)
+		return
+	}
+
+	name := model.Name.ValueString()
+	itemType := model.ItemType.ValueString()
+	operation := model.Operation.ValueString()
+	description := fmt.Sprintf("%s %q in %s", itemType, name, keyVaultId)
+
+	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "running action", fmt.Sprintf("looking up base uri for %s: %+v", description, err))
+		return
+	}
+
+	dataPlaneClient := keyVaultsClient.DataPlaneKeyVaultClient
+	var client keyVaultSoftDeletedItemClient
+	switch itemType {
+	case keyVaultSoftDeletedItemTypeCertificate:
+		deletedClient := dataPlaneClient.DeletedCertificates.Clone(*keyVaultBaseUri)
+		itemClient := dataPlaneClient.Certificates.Clone(*keyVaultBaseUri)
+		deletedId := deletedcertificates.NewDeletedcertificateID(*keyVaultBaseUri, name)
+		itemId := certificates.NewCertificateversionID(*keyVaultBaseUri, name, "")
+		client = keyVaultSoftDeletedItemClient{
+			purge: func(ctx context.Context) (*http.Response, error) {
+				r, err := deletedClient.PurgeDeletedCertificate(ctx, deletedId)
+				return r.HttpResponse, err
+			},
+			getDeleted: func(ctx context.Context) (*http.Response, error) {
+				r, err := deletedClient.GetDeletedCertificate(ctx, deletedId)
+				return r.HttpResponse, err
+			},
+			recover: func(ctx context.Context) (*http.Response, error) {
+				r, err := deletedClient.RecoverDeletedCertificate(ctx, deletedId)
+				return r.HttpResponse, err
+			},
+			get: func(ctx context.Context) (*http.Response, error) {
+				r, err := itemClient.GetCertificate(ctx, itemId)
+				return r.HttpResponse, err
+			},
+		}
+
+	case keyVaultSoftDeletedItemTypeKey:
+		deletedClient := dataPlaneClient.DeletedKeys.Clone(*keyVaultBaseUri)
+		itemClient := dataPlaneClient.Keys.Clone(*keyVaultBaseUri)
+		deletedId := deletedkeys.NewDeletedkeyID(*keyVaultBaseUri, name)
+		itemId := keys.NewKeyversionID(*keyVaultBaseUri, name, "")
+		client = keyVaultSoftDeletedItemClient{
+			purge: func(ctx context.Context) (*http.Response, error) {
+				r, err := deletedClient.PurgeDeletedKey(ctx, deletedId)
+				return r.HttpResponse, err
+			},
+			getDeleted: func(ctx context.Context) (*http.Response, error) {
+				r, err := deletedClient.GetDeletedKey(ctx, deletedId)
+				return r.HttpResponse, err
+			},
+			recover: func(ctx context.Context) (*http.Response, error) {
+				r, err := deletedClient.RecoverDeletedKey(ctx, deletedId)
+				return r.HttpResponse, err
+			},
+			get: func(ctx context.Context) (*http.Response, error) {
+				r, err := itemClient.GetKey(ctx, itemId)
+				return r.HttpResponse, err
+			},
+		}
+
+	case keyVaultSoftDeletedItemTypeSecret:
+		deletedClient := dataPlaneClient.DeletedSecrets.Clone(*keyVaultBaseUri)
+		itemClient := dataPlaneClient.Secrets.Clone(*keyVaultBaseUri)
+		deletedId := deletedsecrets.NewDeletedsecretID(*keyVaultBaseUri, name)
+		itemId := secrets.NewSecretversionID(*keyVaultBaseUri, name, "")
+		client = keyVaultSoftDeletedItemClient{
+			purge: func(ctx context.Context) (*http.Response, error) {
+				r, err := deletedClient.PurgeDeletedSecret(ctx, deletedId)
+				return r.HttpResponse, err
+			},
+			getDeleted: func(ctx context.Context) (*http.Response, error) {
+				r, err := deletedClient.GetDeletedSecret(ctx, deletedId)
+				return r.HttpResponse, err
+			},
+			recover: func(ctx context.Context) (*http.Response, error) {
+				r, err := deletedClient.RecoverDeletedSecret(ctx, deletedId)
+				return r.HttpResponse, err
+			},
+			get: func(ctx context.Context) (*http.Response, error) {
+				r, err := itemClient.GetSecret(ctx, itemId)
+				return r.HttpResponse, err
+			},
+		}
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(resp, "running action", "internal-error: context had no deadline")
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("running %s on soft-deleted %s", operation, description),
+	})
+
+	switch operation {
+	case keyVaultSoftDeletedItemOperationPurge:
+		err := pluginsdk.Retry(time.Until(deadline), func() *pluginsdk.RetryError {
+			_, err := client.purge(ctx)
+			if err == nil {
+				return nil
+			}
+			if strings.Contains(err.Error(), "is currently being deleted") {
+				return pluginsdk.RetryableError(fmt.Errorf("%s is currently being deleted, retrying", description))
+			}
+			return pluginsdk.NonRetryableError(fmt.Errorf("purging %s: %+v", description, err))
+		})
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, "running action", err)
+			return
+		}
+
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:                   []string{"InProgress"},
+			Target:                    []string{"NotFound"},
+			Refresh:                   keyVaultSoftDeletedItemRefreshFunc(ctx, client.getDeleted, "InProgress", "NotFound"),
+			ContinuousTargetOccurence: 3,
+			PollInterval:              5 * time.Second,
+			Timeout:                   time.Until(deadline),
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, "running action", fmt.Sprintf("waiting for %s to be purged: %+v", description, err))
+			return
+		}
+
+	case keyVaultSoftDeletedItemOperationRecover:
+		if _, err := client.recover(ctx); err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, "running action", fmt.Sprintf("recovering %s: %+v", description, err))
+			return
+		}
+
+		// recovered items are not as readily available as newly created items, so we wait for consistency
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:                   []string{"NotFound"},
+			Target:                    []string{"Available"},
+			Refresh:                   keyVaultSoftDeletedItemRefreshFunc(ctx, client.get, "Available", "NotFound"),
+			ContinuousTargetOccurence: 3,
+			PollInterval:              5 * time.Second,
+			Timeout:                   time.Until(deadline),
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, "running action", fmt.Sprintf("waiting for %s to be recovered: %+v", description, err))
+			return
+		}
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("%s of %s completed", operation, description),
+	})
+}
+
+func (k *KeyVaultSoftDeletedItemAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	k.Defaults(ctx, request, response)
+}
+
+// keyVaultSoftDeletedItemRefreshFunc returns `foundState` whilst the item can be retrieved, and `notFoundState` once
+// the item no longer exists
+func keyVaultSoftDeletedItemRefreshFunc(ctx context.Context, get func(ctx context.Context) (*http.Response, error), foundState, notFoundState string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		httpResp, err := get(ctx)
+		if err != nil {
+			if response.WasNotFound(httpResp) {
+				return notFoundState, notFoundState, nil
+			}
+
+			return nil, "Error", err
+		}
+
+		return foundState, foundState, nil
+	}
+}
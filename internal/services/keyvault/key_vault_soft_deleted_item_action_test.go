@@ -0,0 +1,120 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type KeyVaultSoftDeletedItemAction struct{}
+
+func TestAccKeyVaultSoftDeletedItemAction_recover(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_soft_deleted_item", "test")
+	a := KeyVaultSoftDeletedItemAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.secret(data),
+			},
+			{
+				// removing the secret from the configuration soft-deletes it
+				Config: a.template(data),
+			},
+			{
+				Config: a.operation(data, "recover"),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func TestAccKeyVaultSoftDeletedItemAction_purge(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_soft_deleted_item", "test")
+	a := KeyVaultSoftDeletedItemAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.secret(data),
+			},
+			{
+				// removing the secret from the configuration soft-deletes it
+				Config: a.template(data),
+			},
+			{
+				Config: a.operation(data, "purge"),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *KeyVaultSoftDeletedItemAction) secret(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "secret-%s"
+  value        = "rick-and-morty"
+  key_vault_id = azurerm_key_vault.test.id
+}
+`, a.template(data), data.RandomString)
+}
+
+func (a *KeyVaultSoftDeletedItemAction) operation(data acceptance.TestData, operation string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_key_vault.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_key_vault_soft_deleted_item.test]
+    }
+  }
+}
+
+action "azurerm_key_vault_soft_deleted_item" "test" {
+  config {
+    name         = "secret-%[2]s"
+    key_vault_id = azurerm_key_vault.test.id
+    item_type    = "secret"
+    operation    = "%[3]s"
+  }
+}
+`, a.template(data), data.RandomString, operation)
+}
+
+func (a *KeyVaultSoftDeletedItemAction) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_deleted_secrets_on_destroy = false
+      recover_soft_deleted_secrets          = false
+    }
+  }
+}
+
+%s
+`, KeyVaultSecretResource{}.template(data))
+}
@@ -64,7 +64,12 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 }
 
 func (r Registration) Actions() []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		newKeyVaultCertificateRenewAction,
+		newKeyVaultKeyRotateAction,
+		newKeyVaultSecretRotateAction,
+		newKeyVaultSoftDeletedItemAction,
+	}
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/failovergroups"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type MsSqlFailoverGroupFailoverAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &MsSqlFailoverGroupFailoverAction{}
+
+func newMsSqlFailoverGroupFailoverAction() action.Action {
+	return &MsSqlFailoverGroupFailoverAction{}
+}
+
+type MsSqlFailoverGroupFailoverActionModel struct {
+	FailoverGroupId types.String `tfsdk:"failover_group_id"`
+	FailoverMode    types.String `tfsdk:"failover_mode"`
+	Timeout         types.String `tfsdk:"timeout"`
+}
+
+func (m *MsSqlFailoverGroupFailoverAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"failover_group_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the failover group to fail over. The secondary partner server becomes the primary server.",
+				MarkdownDescription: "The ID of the failover group to fail over. The secondary partner server becomes the primary server.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: failovergroups.ValidateFailoverGroupID,
+					},
+				},
+			},
+
+			"failover_mode": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The type of failover to perform. Possible values include `planned`, `forced`, and `try_planned_before_forced`. Defaults to `planned`.",
+				MarkdownDescription: "The type of failover to perform. Possible values include `planned`, `forced`, and `try_planned_before_forced`. Defaults to `planned`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"forced",
+						"planned",
+						"try_planned_before_forced",
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (m *MsSqlFailoverGroupFailoverAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_mssql_failover_group_failover"
+}
+
+func (m *MsSqlFailoverGroupFailoverAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := m.Client.MSSQL.FailoverGroupsClient
+
+	model := MsSqlFailoverGroupFailoverActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+		timeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	id, err := failovergroups.ParseFailoverGroupID(model.FailoverGroupId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing ID", err)
+		return
+	}
+
+	failoverMode := "planned"
+	if v := model.FailoverMode; !v.IsNull() {
+		failoverMode = v.ValueString()
+	}
+
+	// a failover has to be requested on the secondary server, so when the ID of the failover group on the primary
+	// server is specified (as exported by `azurerm_mssql_failover_group`) the secondary partner server is looked up
+	secondaryId, err := m.secondaryFailoverGroupId(ctx, client, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking %s failover of `%s` to server `%s`", failoverMode, id.FailoverGroupName, secondaryId.ServerName),
+	})
+
+	switch failoverMode {
+	case "planned":
+		if err := client.FailoverThenPoll(ctx, *secondaryId); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Errorf("failing over %s: %w", secondaryId, err))
+			return
+		}
+
+	case "forced":
+		if err := client.ForceFailoverAllowDataLossThenPoll(ctx, *secondaryId); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Errorf("forcing failover of %s: %w", secondaryId, err))
+			return
+		}
+
+	case "try_planned_before_forced":
+		if err := client.TryPlannedBeforeForcedFailoverThenPoll(ctx, *secondaryId); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Errorf("failing over %s: %w", secondaryId, err))
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("server `%s` is now the primary of `%s`", secondaryId.ServerName, id.FailoverGroupName),
+	})
+}
+
+func (m *MsSqlFailoverGroupFailoverAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	m.Defaults(ctx, request, response)
+}
+
+func (m *MsSqlFailoverGroupFailoverAction) secondaryFailoverGroupId(ctx context.Context, client *failovergroups.FailoverGroupsClient, id failovergroups.FailoverGroupId) (*failovergroups.FailoverGroupId, error) {
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %w", id, err)
+	}
+
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+	props := existing.Model.Properties
+
+	if pointer.From(props.ReplicationRole) == failovergroups.FailoverGroupReplicationRoleSecondary {
+		return &id, nil
+	}
+
+	for _, partner := range props.PartnerServers {
+		if pointer.From(partner.ReplicationRole) != failovergroups.FailoverGroupReplicationRoleSecondary {
+			continue
+		}
+
+		serverId, err := commonids.ParseSqlServerIDInsensitively(partner.Id)
+		if err != nil {
+			return nil, fmt.Errorf("parsing partner server ID for %s: %w", id, err)
+		}
+
+		secondaryId := failovergroups.NewFailoverGroupID(serverId.SubscriptionId, serverId.ResourceGroupName, serverId.ServerName, id.FailoverGroupName)
+		return &secondaryId, nil
+	}
+
+	return nil, fmt.Errorf("no secondary partner server was found for %s", id)
+}
@@ -88,6 +88,7 @@ func (r Registration) Resources() []sdk.Resource {
 
 func (r Registration) Actions() []func() action.Action {
 	return []func() action.Action{
+		newMsSqlFailoverGroupFailoverAction,
 		newMssqlJobExecuteAction,
 	}
 }
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/serverfailover"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/servers"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type MysqlFlexibleServerFailoverAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &MysqlFlexibleServerFailoverAction{}
+
+func newMysqlFlexibleServerFailoverAction() action.Action {
+	return &MysqlFlexibleServerFailoverAction{}
+}
+
+type MysqlFlexibleServerFailoverActionModel struct {
+	ServerId types.String `tfsdk:"server_id"`
+	Timeout  types.String `tfsdk:"timeout"`
+}
+
+func (m *MysqlFlexibleServerFailoverAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the MySQL Flexible Server to fail over to its standby.",
+				MarkdownDescription: "The ID of the MySQL Flexible Server to fail over to its standby.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: servers.ValidateFlexibleServerID,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (m *MysqlFlexibleServerFailoverAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_mysql_flexible_server_failover"
+}
+
+func (m *MysqlFlexibleServerFailoverAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := m.Client.MySQL.FlexibleServers.ServerFailover
+
+	model := MysqlFlexibleServerFailoverActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := serverfailover.ParseFlexibleServerID(model.ServerId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking failover on %s", id.FlexibleServerName),
+	})
+
+	if err := client.ServersFailoverThenPoll(ctx, *id); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("failing over %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("action failover on %s completed", id.FlexibleServerName),
+	})
+}
+
+func (m *MysqlFlexibleServerFailoverAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	m.Defaults(ctx, request, response)
+}
@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/serverrestart"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/servers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/serverstart"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/serverstop"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type MysqlFlexibleServerPowerAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &MysqlFlexibleServerPowerAction{}
+
+func newMysqlFlexibleServerPowerAction() action.Action {
+	return &MysqlFlexibleServerPowerAction{}
+}
+
+type MysqlFlexibleServerPowerActionModel struct {
+	ServerId types.String `tfsdk:"server_id"`
+	Action   types.String `tfsdk:"power_action"`
+	Timeout  types.String `tfsdk:"timeout"`
+}
+
+func (m *MysqlFlexibleServerPowerAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the MySQL Flexible Server on which to perform the action.",
+				MarkdownDescription: "The ID of the MySQL Flexible Server on which to perform the action.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: servers.ValidateFlexibleServerID,
+					},
+				},
+			},
+
+			"power_action": schema.StringAttribute{
+				Required:            true,
+				Description:         "The power state action to take on this MySQL Flexible Server. Possible values include `restart`, `start`, and `stop`.",
+				MarkdownDescription: "The power state action to take on this MySQL Flexible Server. Possible values include `restart`, `start`, and `stop`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"restart",
+						"start",
+						"stop",
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (m *MysqlFlexibleServerPowerAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_mysql_flexible_server_power"
+}
+
+func (m *MysqlFlexibleServerPowerAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := m.Client.MySQL.FlexibleServers
+
+	model := MysqlFlexibleServerPowerActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := servers.ParseFlexibleServerID(model.ServerId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	powerAction := model.Action.ValueString()
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking %s on %s", powerAction, id.FlexibleServerName),
+	})
+
+	switch powerAction {
+	case "restart":
+		restartId := serverrestart.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName)
+		input := serverrestart.ServerRestartParameter{
+			RestartWithFailover: pointer.To(serverrestart.EnableStatusEnumDisabled),
+		}
+		if err := client.ServerRestart.ServersRestartThenPoll(ctx, restartId, input); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("restarting %s: %+v", id, err))
+			return
+		}
+
+	case "start":
+		startId := serverstart.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName)
+		if err := client.ServerStart.ServersStartThenPoll(ctx, startId); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting %s: %+v", id, err))
+			return
+		}
+
+	case "stop":
+		stopId := serverstop.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName)
+		if err := client.ServerStop.ServersStopThenPoll(ctx, stopId); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("stopping %s: %+v", id, err))
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("action %s on %s completed", powerAction, id.FlexibleServerName),
+	})
+}
+
+func (m *MysqlFlexibleServerPowerAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	m.Defaults(ctx, request, response)
+}
@@ -67,7 +67,10 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 // Actions implements [sdk.FrameworkServiceRegistration].
 func (r Registration) Actions() []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		newMysqlFlexibleServerFailoverAction,
+		newMysqlFlexibleServerPowerAction,
+	}
 }
 
 // EphemeralResources implements [sdk.FrameworkServiceRegistration].
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2025-08-01/servers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type PostgresqlFlexibleServerFailoverAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &PostgresqlFlexibleServerFailoverAction{}
+
+func newPostgresqlFlexibleServerFailoverAction() action.Action {
+	return &PostgresqlFlexibleServerFailoverAction{}
+}
+
+type PostgresqlFlexibleServerFailoverActionModel struct {
+	ServerId     types.String `tfsdk:"server_id"`
+	FailoverMode types.String `tfsdk:"failover_mode"`
+	Timeout      types.String `tfsdk:"timeout"`
+}
+
+func (p *PostgresqlFlexibleServerFailoverAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the PostgreSQL Flexible Server to fail over to its standby.",
+				MarkdownDescription: "The ID of the PostgreSQL Flexible Server to fail over to its standby.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: servers.ValidateFlexibleServerID,
+					},
+				},
+			},
+
+			"failover_mode": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The type of failover to perform. Possible values include `planned_failover`, `forced_failover`, `planned_switchover`, and `forced_switchover`. Defaults to `planned_failover`.",
+				MarkdownDescription: "The type of failover to perform. Possible values include `planned_failover`, `forced_failover`, `planned_switchover`, and `forced_switchover`. Defaults to `planned_failover`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"forced_failover",
+						"forced_switchover",
+						"planned_failover",
+						"planned_switchover",
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (p *PostgresqlFlexibleServerFailoverAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_postgresql_flexible_server_failover"
+}
+
+func (p *PostgresqlFlexibleServerFailoverAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := p.Client.Postgres.FlexibleServersClient
+
+	model := PostgresqlFlexibleServerFailoverActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := servers.ParseFlexibleServerID(model.ServerId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	failoverMode := servers.FailoverModePlannedFailover
+	switch model.FailoverMode.ValueString() {
+	case "forced_failover":
+		failoverMode = servers.FailoverModeForcedFailover
+	case "forced_switchover":
+		failoverMode = servers.FailoverModeForcedSwitchover
+	case "planned_switchover":
+		failoverMode = servers.FailoverModePlannedSwitchover
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking %s on %s", failoverMode, id.FlexibleServerName),
+	})
+
+	// failing over to the standby is performed as a restart of the primary server
+	input := servers.RestartParameter{
+		RestartWithFailover: pointer.To(true),
+		FailoverMode:        pointer.To(failoverMode),
+	}
+	if err := client.RestartThenPoll(ctx, *id, input); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("failing over %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("action %s on %s completed", failoverMode, id.FlexibleServerName),
+	})
+}
+
+func (p *PostgresqlFlexibleServerFailoverAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	p.Defaults(ctx, request, response)
+}
@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2025-08-01/servers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type PostgresqlFlexibleServerPowerAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &PostgresqlFlexibleServerPowerAction{}
+
+func newPostgresqlFlexibleServerPowerAction() action.Action {
+	return &PostgresqlFlexibleServerPowerAction{}
+}
+
+type PostgresqlFlexibleServerPowerActionModel struct {
+	ServerId types.String `tfsdk:"server_id"`
+	Action   types.String `tfsdk:"power_action"`
+	Timeout  types.String `tfsdk:"timeout"`
+}
+
+func (p *PostgresqlFlexibleServerPowerAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the PostgreSQL Flexible Server on which to perform the action.",
+				MarkdownDescription: "The ID of the PostgreSQL Flexible Server on which to perform the action.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: servers.ValidateFlexibleServerID,
+					},
+				},
+			},
+
+			"power_action": schema.StringAttribute{
+				Required:            true,
+				Description:         "The power state action to take on this PostgreSQL Flexible Server. Possible values include `restart`, `start`, and `stop`.",
+				MarkdownDescription: "The power state action to take on this PostgreSQL Flexible Server. Possible values include `restart`, `start`, and `stop`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"restart",
+						"start",
+						"stop",
+					),
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (p *PostgresqlFlexibleServerPowerAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_postgresql_flexible_server_power"
+}
+
+func (p *PostgresqlFlexibleServerPowerAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := p.Client.Postgres.FlexibleServersClient
+
+	model := PostgresqlFlexibleServerPowerActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := servers.ParseFlexibleServerID(model.ServerId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	powerAction := model.Action.ValueString()
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("invoking %s on %s", powerAction, id.FlexibleServerName),
+	})
+
+	switch powerAction {
+	case "restart":
+		if err := client.RestartThenPoll(ctx, *id, servers.RestartParameter{}); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("restarting %s: %+v", id, err))
+			return
+		}
+
+	case "start":
+		if err := client.StartThenPoll(ctx, *id); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting %s: %+v", id, err))
+			return
+		}
+
+	case "stop":
+		if err := client.StopThenPoll(ctx, *id); err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("stopping %s: %+v", id, err))
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("action %s on %s completed", powerAction, id.FlexibleServerName),
+	})
+}
+
+func (p *PostgresqlFlexibleServerPowerAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	p.Defaults(ctx, request, response)
+}
@@ -83,7 +83,10 @@ func (r Registration) DataSources() []sdk.DataSource {
 }
 
 func (r Registration) Actions() []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		newPostgresqlFlexibleServerFailoverAction,
+		newPostgresqlFlexibleServerPowerAction,
+	}
 }
 
 func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
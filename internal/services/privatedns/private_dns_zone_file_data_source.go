@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatedns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatezones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = PrivateDnsZoneFileDataSource{}
+
+type PrivateDnsZoneFileDataSource struct{}
+
+type PrivateDnsZoneFileDataSourceModel struct {
+	PrivateDnsZoneId string `tfschema:"private_dns_zone_id"`
+	Content          string `tfschema:"content"`
+}
+
+func (PrivateDnsZoneFileDataSource) ModelObject() interface{} {
+	return &PrivateDnsZoneFileDataSourceModel{}
+}
+
+func (PrivateDnsZoneFileDataSource) ResourceType() string {
+	return "azurerm_private_dns_zone_file"
+}
+
+func (PrivateDnsZoneFileDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_dns_zone_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: privatezones.ValidatePrivateDnsZoneID,
+		},
+	}
+}
+
+func (PrivateDnsZoneFileDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (PrivateDnsZoneFileDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			zonesClient := metadata.Client.PrivateDns.PrivateZonesClient
+			client := metadata.Client.PrivateDns.RecordSetsClient
+
+			var state PrivateDnsZoneFileDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := privatezones.ParsePrivateDnsZoneID(state.PrivateDnsZoneId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := zonesClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			zone := zonefile.Zone{
+				Origin:     zonefile.Fqdn(id.PrivateDnsZoneName),
+				RecordSets: make([]zonefile.RecordSet, 0),
+			}
+
+			// there's no operation to list every record set in a private zone with this API version, so list each type instead
+			for _, recordType := range privatedns.PossibleValuesForRecordType() {
+				resp, err := client.RecordSetsListByTypeComplete(ctx, privatedns.NewPrivateZoneID(id.SubscriptionId, id.ResourceGroupName, id.PrivateDnsZoneName, privatedns.RecordType(recordType)), privatedns.DefaultRecordSetsListByTypeOperationOptions())
+				if err != nil {
+					return fmt.Errorf("listing %s record sets for %s: %+v", recordType, id, err)
+				}
+
+				for _, item := range resp.Items {
+					if item.Properties != nil && item.Properties.SoaRecord != nil {
+						zone.SOA = flattenPrivateDnsZoneFileSOA(pointer.From(item.Properties.Ttl), *item.Properties.SoaRecord)
+						continue
+					}
+
+					if rs := flattenPrivateDnsZoneRecordsRecordSet(item); rs != nil {
+						zone.RecordSets = append(zone.RecordSets, *rs)
+					}
+				}
+			}
+
+			metadata.SetID(id)
+
+			state.PrivateDnsZoneId = id.ID()
+			state.Content = zonefile.Render(zone)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenPrivateDnsZoneFileSOA(ttl int64, input privatedns.SoaRecord) *zonefile.SOA {
+	return &zonefile.SOA{
+		TTL:     ttl,
+		Host:    zonefile.Fqdn(pointer.From(input.Host)),
+		Email:   zonefile.Fqdn(pointer.From(input.Email)),
+		Serial:  pointer.From(input.SerialNumber),
+		Refresh: pointer.From(input.RefreshTime),
+		Retry:   pointer.From(input.RetryTime),
+		Expire:  pointer.From(input.ExpireTime),
+		Minimum: pointer.From(input.MinimumTtl),
+	}
+}
@@ -0,0 +1,575 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatedns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatezones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/zonefile"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PrivateDnsZoneRecordsResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = PrivateDnsZoneRecordsResource{}
+	_ sdk.ResourceWithCustomizeDiff = PrivateDnsZoneRecordsResource{}
+)
+
+type PrivateDnsZoneRecordsResourceModel struct {
+	PrivateDnsZoneId string                                        `tfschema:"private_dns_zone_id"`
+	ZoneFile         string                                        `tfschema:"zone_file"`
+	Authoritative    bool                                          `tfschema:"authoritative"`
+	DefaultTTL       int64                                         `tfschema:"default_ttl"`
+	RecordSet        []PrivateDnsZoneRecordsRecordSetResourceModel `tfschema:"record_set"`
+}
+
+type PrivateDnsZoneRecordsRecordSetResourceModel struct {
+	Name    string   `tfschema:"name"`
+	Type    string   `tfschema:"type"`
+	TTL     int64    `tfschema:"ttl"`
+	Records []string `tfschema:"records"`
+}
+
+func (PrivateDnsZoneRecordsResource) ModelObject() interface{} {
+	return &PrivateDnsZoneRecordsResourceModel{}
+}
+
+func (PrivateDnsZoneRecordsResource) ResourceType() string {
+	return "azurerm_private_dns_zone_records"
+}
+
+func (PrivateDnsZoneRecordsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return privatezones.ValidatePrivateDnsZoneID
+}
+
+func (PrivateDnsZoneRecordsResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_dns_zone_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: privatezones.ValidatePrivateDnsZoneID,
+		},
+
+		"zone_file": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"authoritative": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"default_ttl": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      3600,
+			ValidateFunc: validation.IntBetween(0, 2147483647),
+		},
+	}
+}
+
+func (PrivateDnsZoneRecordsResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"record_set": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"ttl": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"records": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r PrivateDnsZoneRecordsResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			// the name of the zone is required to qualify relative names, which isn't known until the zone exists
+			if !diff.NewValueKnown("private_dns_zone_id") || !diff.NewValueKnown("zone_file") {
+				return diff.SetNewComputed("record_set")
+			}
+
+			zoneId, err := privatezones.ParsePrivateDnsZoneID(diff.Get("private_dns_zone_id").(string))
+			if err != nil {
+				return err
+			}
+
+			zone, err := parsePrivateDnsZoneRecordsZoneFile(diff.Get("zone_file").(string), zoneId.PrivateDnsZoneName, int64(diff.Get("default_ttl").(int)))
+			if err != nil {
+				return fmt.Errorf("parsing `zone_file`: %+v", err)
+			}
+
+			// the record sets in state reflect the zone, so any difference to the zone file requires an update
+			expected := flattenPrivateDnsZoneRecordsRecordSets(zonefile.Managed(zone.RecordSets, zone.RecordSets, true))
+
+			var state PrivateDnsZoneRecordsResourceModel
+			if err := metadata.DecodeDiff(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if !privateDnsZoneRecordsRecordSetsEqual(state.RecordSet, expected) {
+				return diff.SetNew("record_set", expected)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsZoneRecordsResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			zonesClient := metadata.Client.PrivateDns.PrivateZonesClient
+
+			var model PrivateDnsZoneRecordsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := privatezones.ParsePrivateDnsZoneID(model.PrivateDnsZoneId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := zonesClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// record sets which already exist would be taken over by this resource - and when authoritative, the record
+			// sets not declared in the zone file deleted - so these need to be imported first
+			zone, err := parsePrivateDnsZoneRecordsZoneFile(model.ZoneFile, id.PrivateDnsZoneName, model.DefaultTTL)
+			if err != nil {
+				return fmt.Errorf("parsing `zone_file`: %+v", err)
+			}
+
+			existingRecordSets, _, err := r.listRecordSets(ctx, metadata, *id)
+			if err != nil {
+				return fmt.Errorf("checking for existing record sets in %s: %+v", id, err)
+			}
+
+			if !metadata.Client.Features.SkipImportCheckOnCreateAndAllowOverwritingExistingResources && len(zonefile.Managed(zone.RecordSets, existingRecordSets, model.Authoritative)) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := r.reconcile(ctx, metadata, *id, model, nil); err != nil {
+				return fmt.Errorf("creating records for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsZoneRecordsResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			zonesClient := metadata.Client.PrivateDns.PrivateZonesClient
+
+			id, err := privatezones.ParsePrivateDnsZoneID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state PrivateDnsZoneRecordsResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := zonesClient.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state.PrivateDnsZoneId = id.ID()
+			if state.DefaultTTL == 0 {
+				state.DefaultTTL = 3600
+			}
+
+			// when imported there's no zone file, in which case every record set in the zone is exposed
+			declared := make([]zonefile.RecordSet, 0)
+			authoritative := state.Authoritative || state.ZoneFile == ""
+			if state.ZoneFile != "" {
+				zone, err := parsePrivateDnsZoneRecordsZoneFile(state.ZoneFile, id.PrivateDnsZoneName, state.DefaultTTL)
+				if err != nil {
+					return fmt.Errorf("parsing `zone_file`: %+v", err)
+				}
+				declared = zone.RecordSets
+			}
+
+			existingRecordSets, _, err := r.listRecordSets(ctx, metadata, *id)
+			if err != nil {
+				return fmt.Errorf("listing record sets for %s: %+v", id, err)
+			}
+
+			state.RecordSet = flattenPrivateDnsZoneRecordsRecordSets(zonefile.Managed(declared, existingRecordSets, authoritative))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateDnsZoneRecordsResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := privatezones.ParsePrivateDnsZoneID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsZoneRecordsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// record sets which were removed from the zone file are deleted even when the resource isn't authoritative
+			previouslyDeclared := make([]zonefile.RecordSet, 0)
+			oldZoneFile, _ := metadata.ResourceData.GetChange("zone_file")
+			oldDefaultTTL, _ := metadata.ResourceData.GetChange("default_ttl")
+			if v := oldZoneFile.(string); v != "" {
+				previous, err := parsePrivateDnsZoneRecordsZoneFile(v, id.PrivateDnsZoneName, int64(oldDefaultTTL.(int)))
+				if err == nil {
+					previouslyDeclared = previous.RecordSets
+				}
+			}
+
+			if err := r.reconcile(ctx, metadata, *id, model, previouslyDeclared); err != nil {
+				return fmt.Errorf("updating records for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateDnsZoneRecordsResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.PrivateDns.RecordSetsClient
+
+			id, err := privatezones.ParsePrivateDnsZoneID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PrivateDnsZoneRecordsResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			zone, err := parsePrivateDnsZoneRecordsZoneFile(model.ZoneFile, id.PrivateDnsZoneName, model.DefaultTTL)
+			if err != nil {
+				return fmt.Errorf("parsing `zone_file`: %+v", err)
+			}
+
+			// only the declared record sets are removed, any others were either removed when authoritative or aren't
+			// managed by this resource
+			for _, rs := range zone.RecordSets {
+				if rs.IsManagedByAzure() {
+					continue
+				}
+
+				recordSetId := privatedns.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.PrivateDnsZoneName, privatedns.RecordType(rs.Type), rs.Name)
+				if resp, err := client.RecordSetsDelete(ctx, recordSetId, privatedns.DefaultRecordSetsDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", recordSetId, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// reconcile creates, updates and deletes the record sets in the zone so that they match the zone file
+func (r PrivateDnsZoneRecordsResource) reconcile(ctx context.Context, metadata sdk.ResourceMetaData, id privatezones.PrivateDnsZoneId, model PrivateDnsZoneRecordsResourceModel, previouslyDeclared []zonefile.RecordSet) error {
+	client := metadata.Client.PrivateDns.RecordSetsClient
+
+	zone, err := parsePrivateDnsZoneRecordsZoneFile(model.ZoneFile, id.PrivateDnsZoneName, model.DefaultTTL)
+	if err != nil {
+		return fmt.Errorf("parsing `zone_file`: %+v", err)
+	}
+
+	existing, existingMetadata, err := r.listRecordSets(ctx, metadata, id)
+	if err != nil {
+		return fmt.Errorf("listing record sets: %+v", err)
+	}
+
+	changes := zonefile.Reconcile(zone.RecordSets, existing, previouslyDeclared, model.Authoritative)
+
+	for _, rs := range changes.Deletions {
+		recordSetId := privatedns.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.PrivateDnsZoneName, privatedns.RecordType(rs.Type), rs.Name)
+		if resp, err := client.RecordSetsDelete(ctx, recordSetId, privatedns.DefaultRecordSetsDeleteOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %s: %+v", recordSetId, err)
+		}
+	}
+
+	for _, rs := range changes.Upserts {
+		recordSetId := privatedns.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.PrivateDnsZoneName, privatedns.RecordType(rs.Type), rs.Name)
+		parameters := expandPrivateDnsZoneRecordsRecordSet(rs, existingMetadata[rs.Key()])
+		if _, err := client.RecordSetsCreateOrUpdate(ctx, recordSetId, parameters, privatedns.DefaultRecordSetsCreateOrUpdateOperationOptions()); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", recordSetId, err)
+		}
+	}
+
+	return nil
+}
+
+// listRecordSets returns every record set within the zone, along with the metadata of each record set keyed by the
+// record set key so that it can be retained when the record set is updated
+func (r PrivateDnsZoneRecordsResource) listRecordSets(ctx context.Context, metadata sdk.ResourceMetaData, id privatezones.PrivateDnsZoneId) ([]zonefile.RecordSet, map[string]*map[string]string, error) {
+	client := metadata.Client.PrivateDns.RecordSetsClient
+
+	result := make([]zonefile.RecordSet, 0)
+	recordSetMetadata := make(map[string]*map[string]string)
+
+	// there's no operation to list every record set in a private zone with this API version, so list each type instead
+	for _, recordType := range privatedns.PossibleValuesForRecordType() {
+		resp, err := client.RecordSetsListByTypeComplete(ctx, privatedns.NewPrivateZoneID(id.SubscriptionId, id.ResourceGroupName, id.PrivateDnsZoneName, privatedns.RecordType(recordType)), privatedns.DefaultRecordSetsListByTypeOperationOptions())
+		if err != nil {
+			return nil, nil, fmt.Errorf("listing %s record sets: %+v", recordType, err)
+		}
+
+		for _, item := range resp.Items {
+			// records which were registered by a virtual network link are managed by Azure
+			if item.Properties != nil && pointer.From(item.Properties.IsAutoRegistered) {
+				continue
+			}
+
+			rs := flattenPrivateDnsZoneRecordsRecordSet(item)
+			if rs == nil {
+				continue
+			}
+			result = append(result, *rs)
+			recordSetMetadata[rs.Key()] = item.Properties.Metadata
+		}
+	}
+
+	return result, recordSetMetadata, nil
+}
+
+func expandPrivateDnsZoneRecordsRecordSet(input zonefile.RecordSet, metadata *map[string]string) privatedns.RecordSet {
+	props := privatedns.RecordSetProperties{
+		Metadata: metadata,
+		Ttl:      pointer.To(input.TTL),
+	}
+
+	switch input.Type {
+	case zonefile.RecordTypeA:
+		records := make([]privatedns.ARecord, 0)
+		for _, v := range input.Records {
+			records = append(records, privatedns.ARecord{IPv4Address: pointer.To(v.Value)})
+		}
+		props.ARecords = &records
+	case zonefile.RecordTypeAAAA:
+		records := make([]privatedns.AaaaRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, privatedns.AaaaRecord{IPv6Address: pointer.To(v.Value)})
+		}
+		props.AaaaRecords = &records
+	case zonefile.RecordTypeCNAME:
+		if len(input.Records) > 0 {
+			props.CnameRecord = &privatedns.CnameRecord{Cname: pointer.To(strings.TrimSuffix(input.Records[0].Value, "."))}
+		}
+	case zonefile.RecordTypeMX:
+		records := make([]privatedns.MxRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, privatedns.MxRecord{
+				Preference: pointer.To(v.Preference),
+				Exchange:   pointer.To(strings.TrimSuffix(v.Exchange, ".")),
+			})
+		}
+		props.MxRecords = &records
+	case zonefile.RecordTypePTR:
+		records := make([]privatedns.PtrRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, privatedns.PtrRecord{Ptrdname: pointer.To(strings.TrimSuffix(v.Value, "."))})
+		}
+		props.PtrRecords = &records
+	case zonefile.RecordTypeSRV:
+		records := make([]privatedns.SrvRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, privatedns.SrvRecord{
+				Priority: pointer.To(v.Priority),
+				Weight:   pointer.To(v.Weight),
+				Port:     pointer.To(v.Port),
+				Target:   pointer.To(strings.TrimSuffix(v.Target, ".")),
+			})
+		}
+		props.SrvRecords = &records
+	case zonefile.RecordTypeTXT:
+		records := make([]privatedns.TxtRecord, 0)
+		for _, v := range input.Records {
+			records = append(records, privatedns.TxtRecord{Value: pointer.To(v.Text)})
+		}
+		props.TxtRecords = &records
+	}
+
+	return privatedns.RecordSet{
+		Name:       pointer.To(input.Name),
+		Properties: &props,
+	}
+}
+
+// flattenPrivateDnsZoneRecordsRecordSet converts a record set into its zone file representation, returning nil for record
+// types which can't be represented
+func flattenPrivateDnsZoneRecordsRecordSet(input privatedns.RecordSet) *zonefile.RecordSet {
+	if input.Name == nil || input.Type == nil || input.Properties == nil {
+		return nil
+	}
+
+	// the type is returned in the format `Microsoft.Network/privateDnsZones/A`
+	typeSegments := strings.Split(*input.Type, "/")
+	result := zonefile.RecordSet{
+		Name:    strings.ToLower(*input.Name),
+		Type:    zonefile.RecordType(strings.ToUpper(typeSegments[len(typeSegments)-1])),
+		TTL:     pointer.From(input.Properties.Ttl),
+		Records: make([]zonefile.Record, 0),
+	}
+
+	props := input.Properties
+	switch result.Type {
+	case zonefile.RecordTypeA:
+		for _, v := range pointer.From(props.ARecords) {
+			result.Records = append(result.Records, zonefile.Record{Value: zonefile.NormalizeAddress(pointer.From(v.IPv4Address))})
+		}
+	case zonefile.RecordTypeAAAA:
+		for _, v := range pointer.From(props.AaaaRecords) {
+			result.Records = append(result.Records, zonefile.Record{Value: zonefile.NormalizeAddress(pointer.From(v.IPv6Address))})
+		}
+	case zonefile.RecordTypeCNAME:
+		if props.CnameRecord != nil && props.CnameRecord.Cname != nil {
+			result.Records = append(result.Records, zonefile.Record{Value: zonefile.Fqdn(*props.CnameRecord.Cname)})
+		}
+	case zonefile.RecordTypeMX:
+		for _, v := range pointer.From(props.MxRecords) {
+			result.Records = append(result.Records, zonefile.Record{
+				Preference: pointer.From(v.Preference),
+				Exchange:   zonefile.Fqdn(pointer.From(v.Exchange)),
+			})
+		}
+	case zonefile.RecordTypePTR:
+		for _, v := range pointer.From(props.PtrRecords) {
+			result.Records = append(result.Records, zonefile.Record{Value: zonefile.Fqdn(pointer.From(v.Ptrdname))})
+		}
+	case zonefile.RecordTypeSRV:
+		for _, v := range pointer.From(props.SrvRecords) {
+			result.Records = append(result.Records, zonefile.Record{
+				Priority: pointer.From(v.Priority),
+				Weight:   pointer.From(v.Weight),
+				Port:     pointer.From(v.Port),
+				Target:   zonefile.Fqdn(pointer.From(v.Target)),
+			})
+		}
+	case zonefile.RecordTypeTXT:
+		for _, v := range pointer.From(props.TxtRecords) {
+			result.Records = append(result.Records, zonefile.Record{Text: pointer.From(v.Value)})
+		}
+	case zonefile.RecordTypeSOA:
+		// the SOA record is managed by Azure and exposed through the `azurerm_private_dns_zone` resource
+	default:
+		return nil
+	}
+
+	return &result
+}
+
+func flattenPrivateDnsZoneRecordsRecordSets(input []zonefile.RecordSet) []PrivateDnsZoneRecordsRecordSetResourceModel {
+	result := make([]PrivateDnsZoneRecordsRecordSetResourceModel, 0)
+	for _, rs := range input {
+		result = append(result, PrivateDnsZoneRecordsRecordSetResourceModel{
+			Name:    rs.Name,
+			Type:    string(rs.Type),
+			TTL:     rs.TTL,
+			Records: rs.Values(),
+		})
+	}
+	return result
+}
+
+func privateDnsZoneRecordsRecordSetsEqual(a, b []PrivateDnsZoneRecordsRecordSetResourceModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i].Name != b[i].Name || a[i].Type != b[i].Type || a[i].TTL != b[i].TTL || len(a[i].Records) != len(b[i].Records) {
+			return false
+		}
+		for j := range a[i].Records {
+			if a[i].Records[j] != b[i].Records[j] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// parsePrivateDnsZoneRecordsZoneFile parses the zone file, returning an error for record types which aren't supported
+// within a Private DNS Zone
+func parsePrivateDnsZoneRecordsZoneFile(content, origin string, defaultTTL int64) (*zonefile.Zone, error) {
+	zone, err := zonefile.Parse(content, origin, defaultTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rs := range zone.RecordSets {
+		if rs.Type == zonefile.RecordTypeCAA || rs.Type == zonefile.RecordTypeNS {
+			return nil, fmt.Errorf("the %s record set %q is not supported within a Private DNS Zone", rs.Type, rs.Name)
+		}
+	}
+
+	return zone, nil
+}
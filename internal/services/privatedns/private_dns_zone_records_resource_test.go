@@ -0,0 +1,279 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package privatedns_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatedns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatezones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PrivateDnsZoneRecordsResource struct{}
+
+func TestAccPrivateDnsZoneRecords_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_records", "test")
+	r := PrivateDnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("zone_file", "authoritative", "default_ttl"),
+	})
+}
+
+func TestAccPrivateDnsZoneRecords_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_records", "test")
+	r := PrivateDnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPrivateDnsZoneRecords_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_records", "test")
+	r := PrivateDnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("6"),
+			),
+		},
+		data.ImportStep("zone_file", "authoritative", "default_ttl"),
+	})
+}
+
+func TestAccPrivateDnsZoneRecords_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_records", "test")
+	r := PrivateDnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("zone_file", "authoritative", "default_ttl"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("6"),
+			),
+		},
+		data.ImportStep("zone_file", "authoritative", "default_ttl"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("zone_file", "authoritative", "default_ttl"),
+	})
+}
+
+func TestAccPrivateDnsZoneRecords_authoritative(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_records", "test")
+	r := PrivateDnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.unmanagedRecord(data),
+		},
+		{
+			Config: r.additive(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("2"),
+			),
+		},
+		{
+			// the unmanaged record set is removed from the zone when the resource becomes authoritative
+			Config: r.authoritative(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record_set.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("zone_file", "authoritative", "default_ttl"),
+	})
+}
+
+func TestAccPrivateDnsZoneRecords_unsupportedRecordType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_records", "test")
+	r := PrivateDnsZoneRecordsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.unsupportedRecordType(data),
+			ExpectError: regexp.MustCompile("is not supported within a Private DNS Zone"),
+		},
+	})
+}
+
+func (PrivateDnsZoneRecordsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := privatezones.ParsePrivateDnsZoneID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	recordSetId := privatedns.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.PrivateDnsZoneName, privatedns.RecordTypeA, "www")
+	resp, err := clients.PrivateDns.RecordSetsClient.RecordSetsGet(ctx, recordSetId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", recordSetId, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (PrivateDnsZoneRecordsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r PrivateDnsZoneRecordsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_zone_records" "test" {
+  private_dns_zone_id = azurerm_private_dns_zone.test.id
+  zone_file           = <<ZONE
+www  IN A    192.0.2.1
+www  IN A    192.0.2.2
+ftp  IN CNAME www
+ZONE
+}
+`, r.template(data))
+}
+
+func (r PrivateDnsZoneRecordsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_zone_records" "import" {
+  private_dns_zone_id = azurerm_private_dns_zone_records.test.private_dns_zone_id
+  zone_file           = azurerm_private_dns_zone_records.test.zone_file
+}
+`, r.basic(data))
+}
+
+func (r PrivateDnsZoneRecordsResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_zone_records" "test" {
+  private_dns_zone_id = azurerm_private_dns_zone.test.id
+  authoritative       = true
+  default_ttl         = 300
+  zone_file           = <<ZONE
+$TTL 1h
+@          IN MX    10 mail
+@          IN MX    20 mail.example.net.
+@          IN TXT   "v=spf1 -all"
+www   600  IN A     192.0.2.1
+www        IN AAAA  2001:db8::1
+_sip._tcp  IN SRV   10 60 5060 sip.example.net.
+_dmarc     IN TXT   "v=DMARC1; p=none"
+ZONE
+}
+`, r.template(data))
+}
+
+func (r PrivateDnsZoneRecordsResource) unmanagedRecord(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_a_record" "unmanaged" {
+  name                = "unmanaged"
+  zone_name           = azurerm_private_dns_zone.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  ttl                 = 300
+  records             = ["192.0.2.10"]
+}
+`, r.template(data))
+}
+
+func (r PrivateDnsZoneRecordsResource) additive(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_zone_records" "test" {
+  private_dns_zone_id = azurerm_private_dns_zone.test.id
+  zone_file           = <<ZONE
+www  IN A    192.0.2.1
+ftp  IN CNAME www
+ZONE
+}
+`, r.unmanagedRecord(data))
+}
+
+func (r PrivateDnsZoneRecordsResource) authoritative(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_zone_records" "test" {
+  private_dns_zone_id = azurerm_private_dns_zone.test.id
+  authoritative       = true
+  zone_file           = <<ZONE
+www  IN A    192.0.2.1
+ftp  IN CNAME www
+ZONE
+}
+`, r.template(data))
+}
+
+func (r PrivateDnsZoneRecordsResource) unsupportedRecordType(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_zone_records" "test" {
+  private_dns_zone_id = azurerm_private_dns_zone.test.id
+  zone_file           = <<ZONE
+delegated  IN NS    ns1.example.net.
+ZONE
+}
+`, r.template(data))
+}
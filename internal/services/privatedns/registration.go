@@ -37,7 +37,10 @@ func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
 	return []sdk.FrameworkWrappedResource{}
 }
 
-var _ sdk.FrameworkServiceRegistration = Registration{}
+var (
+	_ sdk.FrameworkServiceRegistration = Registration{}
+	_ sdk.TypedServiceRegistration     = Registration{}
+)
 
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/dns"
@@ -85,3 +88,17 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_private_dns_zone_virtual_network_link": resourcePrivateDnsZoneVirtualNetworkLink(),
 	}
 }
+
+// DataSources returns a list of Data Sources supported by this Service
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{
+		PrivateDnsZoneFileDataSource{},
+	}
+}
+
+// Resources returns a list of Resources supported by this Service
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		PrivateDnsZoneRecordsResource{},
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-11-01/redisresources"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type RedisCacheExportAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &RedisCacheExportAction{}
+
+func newRedisCacheExportAction() action.Action {
+	return &RedisCacheExportAction{}
+}
+
+type RedisCacheExportActionModel struct {
+	RedisCacheId              types.String `tfsdk:"redis_cache_id"`
+	StorageContainerUrl       types.String `tfsdk:"storage_container_url"`
+	Prefix                    types.String `tfsdk:"prefix"`
+	Format                    types.String `tfsdk:"format"`
+	StorageAuthenticationType types.String `tfsdk:"storage_authentication_type"`
+	StorageSubscriptionId     types.String `tfsdk:"storage_subscription_id"`
+	Timeout                   types.String `tfsdk:"timeout"`
+}
+
+func (r *RedisCacheExportAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"redis_cache_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Redis Cache to export data from.",
+				MarkdownDescription: "The ID of the Redis Cache to export data from.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: redisresources.ValidateRediID,
+					},
+				},
+			},
+
+			"storage_container_url": schema.StringAttribute{
+				Required:            true,
+				WriteOnly:           true,
+				Description:         "The URL of the Storage Container to export the data to. When `storage_authentication_type` is `SAS` this must include a SAS token with write permissions.",
+				MarkdownDescription: "The URL of the Storage Container to export the data to. When `storage_authentication_type` is `SAS` this must include a SAS token with write permissions.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsURLWithHTTPS,
+					},
+				},
+			},
+
+			"prefix": schema.StringAttribute{
+				Required:            true,
+				Description:         "The prefix of the name of the blobs the data is exported to.",
+				MarkdownDescription: "The prefix of the name of the blobs the data is exported to.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"format": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The format of the exported blobs. Possible values include `RDB`.",
+				MarkdownDescription: "The format of the exported blobs. Possible values include `RDB`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"RDB",
+					),
+				},
+			},
+
+			"storage_authentication_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The method used to authenticate to the Storage Account. Possible values include `SAS` and `ManagedIdentity`. Defaults to `SAS`.",
+				MarkdownDescription: "The method used to authenticate to the Storage Account. Possible values include `SAS` and `ManagedIdentity`. Defaults to `SAS`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"ManagedIdentity",
+						"SAS",
+					),
+				},
+			},
+
+			"storage_subscription_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Subscription containing the Storage Account, when using `ManagedIdentity` authentication and the Storage Account is in a different Subscription to the Redis Cache.",
+				MarkdownDescription: "The ID of the Subscription containing the Storage Account, when using `ManagedIdentity` authentication and the Storage Account is in a different Subscription to the Redis Cache.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsUUID,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (r *RedisCacheExportAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_redis_cache_export"
+}
+
+func (r *RedisCacheExportAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := r.Client.Redis.RedisResourcesClient
+
+	model := RedisCacheExportActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := redisresources.ParseRediID(model.RedisCacheId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	input := redisresources.ExportRDBParameters{
+		Container:                      model.StorageContainerUrl.ValueString(),
+		Prefix:                         model.Prefix.ValueString(),
+		Format:                         model.Format.ValueStringPointer(),
+		PreferredDataArchiveAuthMethod: model.StorageAuthenticationType.ValueStringPointer(),
+		StorageSubscriptionId:          model.StorageSubscriptionId.ValueStringPointer(),
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("exporting the data of %s with prefix %q", id.RedisName, input.Prefix),
+	})
+
+	if err := client.RedisExportDataThenPoll(ctx, *id, input); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("exporting data from %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("export of %s completed", id.RedisName),
+	})
+}
+
+func (r *RedisCacheExportAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	r.Defaults(ctx, request, response)
+}
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/convert"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-11-01/redisresources"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type RedisCacheImportAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &RedisCacheImportAction{}
+
+func newRedisCacheImportAction() action.Action {
+	return &RedisCacheImportAction{}
+}
+
+type RedisCacheImportActionModel struct {
+	RedisCacheId              types.String                          `tfsdk:"redis_cache_id"`
+	BlobUrls                  typehelpers.ListValueOf[types.String] `tfsdk:"blob_urls"`
+	Format                    types.String                          `tfsdk:"format"`
+	StorageAuthenticationType types.String                          `tfsdk:"storage_authentication_type"`
+	StorageSubscriptionId     types.String                          `tfsdk:"storage_subscription_id"`
+	Timeout                   types.String                          `tfsdk:"timeout"`
+}
+
+func (r *RedisCacheImportAction) Schema(ctx context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"redis_cache_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Redis Cache to import data into.",
+				MarkdownDescription: "The ID of the Redis Cache to import data into.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: redisresources.ValidateRediID,
+					},
+				},
+			},
+
+			"blob_urls": schema.ListAttribute{
+				CustomType:          typehelpers.NewListTypeOf[types.String](ctx),
+				ElementType:         types.StringType,
+				Required:            true,
+				WriteOnly:           true,
+				Description:         "The URLs of the blobs to import the data from. When `storage_authentication_type` is `SAS` each URL must include a SAS token with read permissions.",
+				MarkdownDescription: "The URLs of the blobs to import the data from. When `storage_authentication_type` is `SAS` each URL must include a SAS token with read permissions.",
+				Validators: []validator.List{
+					listvalidator.All(
+						listvalidator.SizeAtLeast(1),
+						listvalidator.NoNullValues(),
+						listvalidator.ValueStringsAre(
+							typehelpers.WrappedStringValidator{
+								Func: validation.IsURLWithHTTPS,
+							},
+						),
+					),
+				},
+			},
+
+			"format": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The format of the blobs being imported. Possible values include `RDB`.",
+				MarkdownDescription: "The format of the blobs being imported. Possible values include `RDB`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"RDB",
+					),
+				},
+			},
+
+			"storage_authentication_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The method used to authenticate to the Storage Account. Possible values include `SAS` and `ManagedIdentity`. Defaults to `SAS`.",
+				MarkdownDescription: "The method used to authenticate to the Storage Account. Possible values include `SAS` and `ManagedIdentity`. Defaults to `SAS`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"ManagedIdentity",
+						"SAS",
+					),
+				},
+			},
+
+			"storage_subscription_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Subscription containing the Storage Account, when using `ManagedIdentity` authentication and the Storage Account is in a different Subscription to the Redis Cache.",
+				MarkdownDescription: "The ID of the Subscription containing the Storage Account, when using `ManagedIdentity` authentication and the Storage Account is in a different Subscription to the Redis Cache.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsUUID,
+					},
+				},
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `60m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `60m`.",
+			},
+		},
+	}
+}
+
+func (r *RedisCacheImportAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_redis_cache_import"
+}
+
+func (r *RedisCacheImportAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := r.Client.Redis.RedisResourcesClient
+
+	model := RedisCacheImportActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 60 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := redisresources.ParseRediID(model.RedisCacheId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	blobUrls := make([]string, 0)
+	convert.Expand(ctx, model.BlobUrls, &blobUrls, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := redisresources.ImportRDBParameters{
+		Files:                          blobUrls,
+		Format:                         model.Format.ValueStringPointer(),
+		PreferredDataArchiveAuthMethod: model.StorageAuthenticationType.ValueStringPointer(),
+		StorageSubscriptionId:          model.StorageSubscriptionId.ValueStringPointer(),
+	}
+
+	// the URLs aren't included in the progress messages since they may contain a SAS token
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("importing %d blob(s) into %s", len(blobUrls), id.RedisName),
+	})
+
+	if err := client.RedisImportDataThenPoll(ctx, *id, input); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("importing data into %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("import into %s completed", id.RedisName),
+	})
+}
+
+func (r *RedisCacheImportAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	r.Defaults(ctx, request, response)
+}
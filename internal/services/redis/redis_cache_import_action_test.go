@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package redis_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type RedisCacheImportAction struct{}
+
+func TestAccRedisCacheImportAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_cache_import", "test")
+	a := RedisCacheImportAction{}
+	e := RedisCacheExportAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				// the data exported from the cache is imported back into it
+				Config: e.basic(data),
+			},
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *RedisCacheImportAction) basic(data acceptance.TestData) string {
+	e := RedisCacheExportAction{}
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "import" {
+  input = terraform_data.trigger.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_redis_cache_import.test]
+    }
+  }
+}
+
+action "azurerm_redis_cache_import" "test" {
+  config {
+    redis_cache_id = azurerm_redis_cache.test.id
+    blob_urls      = ["${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}/acctest${data.azurerm_storage_account_blob_container_sas.test.sas}"]
+  }
+}
+`, e.basic(data))
+}
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-11-01/redisresources"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.EphemeralResource = &RedisCacheKeysEphemeralResource{}
+
+func NewRedisCacheKeysEphemeralResource() ephemeral.EphemeralResource {
+	return &RedisCacheKeysEphemeralResource{}
+}
+
+type RedisCacheKeysEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type RedisCacheKeysEphemeralResourceModel struct {
+	RedisCacheID              types.String `tfsdk:"redis_cache_id"`
+	PrimaryAccessKey          types.String `tfsdk:"primary_access_key"`
+	SecondaryAccessKey        types.String `tfsdk:"secondary_access_key"`
+	PrimaryConnectionString   types.String `tfsdk:"primary_connection_string"`
+	SecondaryConnectionString types.String `tfsdk:"secondary_connection_string"`
+}
+
+func (e *RedisCacheKeysEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_redis_cache_keys"
+}
+
+func (e *RedisCacheKeysEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *RedisCacheKeysEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"redis_cache_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: redisresources.ValidateRediID,
+					},
+				},
+			},
+
+			"primary_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *RedisCacheKeysEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Redis.RedisResourcesClient
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data RedisCacheKeysEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := redisresources.ParseRediID(data.RedisCacheID.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	existing, err := client.RedisGet(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s", id), err)
+		return
+	}
+	if existing.Model == nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s", id), "`model` was nil")
+		return
+	}
+	props := existing.Model.Properties
+
+	if pointer.From(props.DisableAccessKeyAuthentication) {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing keys for %s", id), "access key authentication is disabled for this Redis Cache")
+		return
+	}
+
+	keys, err := client.RedisListKeys(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing keys for %s", id), err)
+		return
+	}
+	if model := keys.Model; model != nil {
+		data.PrimaryAccessKey = types.StringValue(pointer.From(model.PrimaryKey))
+		data.SecondaryAccessKey = types.StringValue(pointer.From(model.SecondaryKey))
+		data.PrimaryConnectionString = types.StringValue(getRedisConnectionString(pointer.From(props.HostName), pointer.From(props.SslPort), pointer.From(model.PrimaryKey), true))
+		data.SecondaryConnectionString = types.StringValue(getRedisConnectionString(pointer.From(props.HostName), pointer.From(props.SslPort), pointer.From(model.SecondaryKey), true))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-11-01/redisresources"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type RedisCacheRebootAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &RedisCacheRebootAction{}
+
+func newRedisCacheRebootAction() action.Action {
+	return &RedisCacheRebootAction{}
+}
+
+type RedisCacheRebootActionModel struct {
+	RedisCacheId types.String `tfsdk:"redis_cache_id"`
+	RebootType   types.String `tfsdk:"reboot_type"`
+	ShardId      types.Int64  `tfsdk:"shard_id"`
+	Timeout      types.String `tfsdk:"timeout"`
+}
+
+func (r *RedisCacheRebootAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"redis_cache_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Redis Cache to reboot.",
+				MarkdownDescription: "The ID of the Redis Cache to reboot.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: redisresources.ValidateRediID,
+					},
+				},
+			},
+
+			"reboot_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Which Redis nodes to reboot. Possible values include `all_nodes`, `primary_node`, and `secondary_node`. Defaults to `all_nodes`.",
+				MarkdownDescription: "Which Redis nodes to reboot. Possible values include `all_nodes`, `primary_node`, and `secondary_node`. Defaults to `all_nodes`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"all_nodes",
+						"primary_node",
+						"secondary_node",
+					),
+				},
+			},
+
+			"shard_id": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The ID of the shard to reboot. Only applicable to clustered Premium caches, when omitted all shards are rebooted.",
+				MarkdownDescription: "The ID of the shard to reboot. Only applicable to clustered Premium caches, when omitted all shards are rebooted.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `10m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `10m`.",
+			},
+		},
+	}
+}
+
+func (r *RedisCacheRebootAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_redis_cache_reboot"
+}
+
+func (r *RedisCacheRebootAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := r.Client.Redis.RedisResourcesClient
+
+	model := RedisCacheRebootActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 10 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := redisresources.ParseRediID(model.RedisCacheId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	rebootType := redisresources.RebootTypeAllNodes
+	switch model.RebootType.ValueString() {
+	case "primary_node":
+		rebootType = redisresources.RebootTypePrimaryNode
+	case "secondary_node":
+		rebootType = redisresources.RebootTypeSecondaryNode
+	}
+
+	input := redisresources.RedisRebootParameters{
+		RebootType: pointer.To(rebootType),
+	}
+	if v := model.ShardId; !v.IsNull() {
+		input.ShardId = v.ValueInt64Pointer()
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("rebooting %s of %s", rebootType, id.RedisName),
+	})
+
+	if _, err := client.RedisForceReboot(ctx, *id, input); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("rebooting %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("reboot of %s requested", id.RedisName),
+	})
+}
+
+func (r *RedisCacheRebootAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	r.Defaults(ctx, request, response)
+}
@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package relay
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.EphemeralResource = &RelayAuthorizationRuleKeysEphemeralResource{}
+
+func NewRelayAuthorizationRuleKeysEphemeralResource() ephemeral.EphemeralResource {
+	return &RelayAuthorizationRuleKeysEphemeralResource{}
+}
+
+type RelayAuthorizationRuleKeysEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type RelayAuthorizationRuleKeysEphemeralResourceModel struct {
+	AuthorizationRuleID       types.String `tfsdk:"authorization_rule_id"`
+	PrimaryKey                types.String `tfsdk:"primary_key"`
+	SecondaryKey              types.String `tfsdk:"secondary_key"`
+	PrimaryConnectionString   types.String `tfsdk:"primary_connection_string"`
+	SecondaryConnectionString types.String `tfsdk:"secondary_connection_string"`
+}
+
+func (e *RelayAuthorizationRuleKeysEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_relay_authorization_rule_keys"
+}
+
+func (e *RelayAuthorizationRuleKeysEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *RelayAuthorizationRuleKeysEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authorization_rule_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.Any(
+							namespaces.ValidateAuthorizationRuleID,
+							hybridconnections.ValidateHybridConnectionAuthorizationRuleID,
+						),
+					},
+				},
+			},
+
+			"primary_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *RelayAuthorizationRuleKeysEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data RelayAuthorizationRuleKeysEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	// the Authorization Rule can belong to either the Namespace or a Hybrid Connection - which use a different API
+	rawId := data.AuthorizationRuleID.ValueString()
+	if id, err := namespaces.ParseAuthorizationRuleID(rawId); err == nil {
+		keysResp, err := e.Client.Relay.NamespacesClient.ListKeys(ctx, *id)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing keys for %s", id), err)
+			return
+		}
+		if model := keysResp.Model; model != nil {
+			data.setKeys(model.PrimaryKey, model.SecondaryKey, model.PrimaryConnectionString, model.SecondaryConnectionString)
+		}
+	} else {
+		id, err := hybridconnections.ParseHybridConnectionAuthorizationRuleID(rawId)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, "", err)
+			return
+		}
+		keysResp, err := e.Client.Relay.HybridConnectionsClient.ListKeys(ctx, *id)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing keys for %s", id), err)
+			return
+		}
+		if model := keysResp.Model; model != nil {
+			data.setKeys(model.PrimaryKey, model.SecondaryKey, model.PrimaryConnectionString, model.SecondaryConnectionString)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (m *RelayAuthorizationRuleKeysEphemeralResourceModel) setKeys(primaryKey, secondaryKey, primaryConnectionString, secondaryConnectionString *string) {
+	m.PrimaryKey = types.StringValue(pointer.From(primaryKey))
+	m.SecondaryKey = types.StringValue(pointer.From(secondaryKey))
+	m.PrimaryConnectionString = types.StringValue(pointer.From(primaryConnectionString))
+	m.SecondaryConnectionString = types.StringValue(pointer.From(secondaryConnectionString))
+}
@@ -101,6 +101,8 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 
+			"what_if": templateDeploymentWhatIfSchema(),
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -108,10 +110,45 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(managementGroupTemplateDeploymentCustomizeDiffWhatIf),
 	}
 }
 
+func managementGroupTemplateDeploymentCustomizeDiffWhatIf(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
+
+	deploymentFields := []string{"name", "management_group_id", "location", "template_content", "template_spec_version_id", "debug_level", "parameters_content", "tags"}
+
+	return customizeDiffTemplateDeploymentWhatIf(ctx, d, resources.DeploymentModeIncremental, deploymentFields, func(ctx context.Context, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+		managementGroupId, err := mgParse.ManagementGroupID(d.Get("management_group_id").(string))
+		if err != nil {
+			return nil, err
+		}
+		deploymentName := d.Get("name").(string)
+
+		future, err := client.WhatIfAtManagementGroupScope(ctx, managementGroupId.Name, deploymentName, resources.ScopedDeploymentWhatIf{
+			Location:   pointer.To(location.Normalize(d.Get("location").(string))),
+			Properties: &properties,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return nil, fmt.Errorf("waiting for the What-If operation for Template Deployment %q (Management Group %q): %+v", deploymentName, managementGroupId.Name, err)
+		}
+		result, err := future.Result(*client)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving the result of the What-If operation for Template Deployment %q (Management Group %q): %+v", deploymentName, managementGroupId.Name, err)
+		}
+
+		return &result, nil
+	})
+}
+
 func managementGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -199,6 +236,11 @@ func managementGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData,
 		return err
 	}
 
+	// the `what_if` block only affects planning, so changes to it alone don't require the deployment to be run again
+	if !d.HasChangesExcept("what_if", "what_if_changes") {
+		return managementGroupTemplateDeploymentResourceRead(d, meta)
+	}
+
 	template, err := client.GetAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName)
 	if err != nil {
 		return fmt.Errorf("retrieving Management Group Template Deployment %q: %+v", id.DeploymentName, err)
@@ -101,6 +101,8 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 
+			"what_if": templateDeploymentWhatIfSchema(),
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -108,12 +110,14 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
 
 		// this is needed to fix https://github.com/hashicorp/terraform-provider-azurerm/issues/12828
 		// On a change to `template_content` or `parameters_content`, we'll set `output_content` to empty
 		// The adverse effect of this is that any change to `template_content` will also cause any resource referencing `output_content` to update
-		CustomizeDiff: func(ctx context.Context, d *pluginsdk.ResourceDiff, i interface{}) error {
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(func(ctx context.Context, d *pluginsdk.ResourceDiff, i interface{}) error {
 			if d.HasChange("template_content") {
 				o, n := d.GetChange("template_content")
 
@@ -133,10 +137,49 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 			}
 
 			return nil
-		},
+		}, resourceGroupTemplateDeploymentCustomizeDiffWhatIf),
 	}
 }
 
+func resourceGroupTemplateDeploymentCustomizeDiffWhatIf(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
+	groupsClient := meta.(*clients.Client).Resource.GroupsClient
+
+	deploymentFields := []string{"name", "resource_group_name", "deployment_mode", "template_content", "template_spec_version_id", "debug_level", "parameters_content", "tags"}
+	mode := resources.DeploymentMode(d.Get("deployment_mode").(string))
+
+	return customizeDiffTemplateDeploymentWhatIf(ctx, d, mode, deploymentFields, func(ctx context.Context, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+		resourceGroupName := d.Get("resource_group_name").(string)
+		deploymentName := d.Get("name").(string)
+
+		// the Resource Group won't exist yet when it's created in the same apply, in which case the changes are unknown
+		exists, err := groupsClient.CheckExistence(ctx, resourceGroupName)
+		if err != nil && !utils.ResponseWasNotFound(exists) {
+			return nil, fmt.Errorf("checking for the presence of Resource Group %q: %+v", resourceGroupName, err)
+		}
+		if utils.ResponseWasNotFound(exists) {
+			log.Printf("[DEBUG] Skipping the What-If operation for Template Deployment %q since Resource Group %q doesn't exist", deploymentName, resourceGroupName)
+			return nil, nil
+		}
+
+		future, err := client.WhatIf(ctx, resourceGroupName, deploymentName, resources.DeploymentWhatIf{
+			Properties: &properties,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return nil, fmt.Errorf("waiting for the What-If operation for Template Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroupName, err)
+		}
+		result, err := future.Result(*client)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving the result of the What-If operation for Template Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroupName, err)
+		}
+
+		return &result, nil
+	})
+}
+
 func resourceGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -220,6 +263,11 @@ func resourceGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, me
 		return err
 	}
 
+	// the `what_if` block only affects planning, so changes to it alone don't require the deployment to be run again
+	if !d.HasChangesExcept("what_if", "what_if_changes") {
+		return resourceGroupTemplateDeploymentResourceRead(d, meta)
+	}
+
 	template, err := client.Get(ctx, id.ResourceGroup, id.DeploymentName)
 	if err != nil {
 		return fmt.Errorf("retrieving Template Deployment %q (Resource Group %q): %+v", id.DeploymentName, id.ResourceGroup, err)
@@ -92,6 +92,8 @@ func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 
+			"what_if": templateDeploymentWhatIfSchema(),
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -99,10 +101,41 @@ func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(subscriptionTemplateDeploymentCustomizeDiffWhatIf),
 	}
 }
 
+func subscriptionTemplateDeploymentCustomizeDiffWhatIf(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
+
+	deploymentFields := []string{"name", "location", "template_content", "template_spec_version_id", "debug_level", "parameters_content", "tags"}
+
+	return customizeDiffTemplateDeploymentWhatIf(ctx, d, resources.DeploymentModeIncremental, deploymentFields, func(ctx context.Context, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+		deploymentName := d.Get("name").(string)
+
+		future, err := client.WhatIfAtSubscriptionScope(ctx, deploymentName, resources.DeploymentWhatIf{
+			Location:   pointer.To(location.Normalize(d.Get("location").(string))),
+			Properties: &properties,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return nil, fmt.Errorf("waiting for the What-If operation for Subscription Template Deployment %q: %+v", deploymentName, err)
+		}
+		result, err := future.Result(*client)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving the result of the What-If operation for Subscription Template Deployment %q: %+v", deploymentName, err)
+		}
+
+		return &result, nil
+	})
+}
+
 func subscriptionTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -187,6 +220,11 @@ func subscriptionTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, met
 		return err
 	}
 
+	// the `what_if` block only affects planning, so changes to it alone don't require the deployment to be run again
+	if !d.HasChangesExcept("what_if", "what_if_changes") {
+		return subscriptionTemplateDeploymentResourceRead(d, meta)
+	}
+
 	template, err := client.GetAtSubscriptionScope(ctx, id.DeploymentName)
 	if err != nil {
 		return fmt.Errorf("retrieving Subscription Template Deployment %q: %+v", id.DeploymentName, err)
@@ -0,0 +1,288 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// templateDeploymentWhatIfRunner runs the What-If operation for a Template Deployment at the scope of the resource
+type templateDeploymentWhatIfRunner func(ctx context.Context, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error)
+
+// templateDeploymentWhatIfReportedChangeTypes are the change types which are surfaced, the remaining change types
+// (`Deploy`, `Ignore` and `NoChange`) don't change the resources within the scope of the deployment
+var templateDeploymentWhatIfReportedChangeTypes = map[resources.ChangeType]struct{}{
+	resources.ChangeTypeCreate: {},
+	resources.ChangeTypeDelete: {},
+	resources.ChangeTypeModify: {},
+}
+
+func templateDeploymentWhatIfSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"ignored_properties": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+
+				"ignored_resource_types": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func templateDeploymentWhatIfChangesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"resource_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"change_type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"changed_properties": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// customizeDiffTemplateDeploymentWhatIf runs the What-If operation when the `what_if` block is specified and the
+// deployment will be run, exposing the predicted changes in `what_if_changes` and logging each as a warning.
+// The `deploymentFields` are the fields which cause the deployment to be run when changed.
+func customizeDiffTemplateDeploymentWhatIf(ctx context.Context, d *pluginsdk.ResourceDiff, mode resources.DeploymentMode, deploymentFields []string, runWhatIf templateDeploymentWhatIfRunner) error {
+	whatIfRaw := d.Get("what_if").([]interface{})
+	if len(whatIfRaw) == 0 {
+		return nil
+	}
+
+	// the deployment is only run when it's created or one of the deployment fields has changed
+	if d.Id() != "" && !d.HasChanges(deploymentFields...) {
+		return nil
+	}
+
+	for _, field := range deploymentFields {
+		if !d.NewValueKnown(field) {
+			log.Printf("[DEBUG] Skipping the What-If operation for the Template Deployment since %q isn't known until apply", field)
+			return d.SetNewComputed("what_if_changes")
+		}
+	}
+
+	properties := resources.DeploymentWhatIfProperties{
+		WhatIfSettings: &resources.DeploymentWhatIfSettings{
+			ResultFormat: resources.WhatIfResultFormatFullResourcePayloads,
+		},
+		Mode: mode,
+	}
+
+	if v := d.Get("template_spec_version_id").(string); v != "" {
+		properties.TemplateLink = &resources.TemplateLink{
+			ID: pointer.To(v),
+		}
+	} else {
+		template, err := expandTemplateDeploymentBody(d.Get("template_content").(string))
+		if err != nil {
+			return fmt.Errorf("expanding `template_content`: %+v", err)
+		}
+		properties.Template = template
+	}
+
+	if v := d.Get("parameters_content").(string); v != "" {
+		parameters, err := expandTemplateDeploymentBody(v)
+		if err != nil {
+			return fmt.Errorf("expanding `parameters_content`: %+v", err)
+		}
+		properties.Parameters = parameters
+	}
+
+	result, err := runWhatIf(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("running the What-If operation for the Template Deployment: %+v", err)
+	}
+	if result == nil {
+		return d.SetNewComputed("what_if_changes")
+	}
+	if result.Error != nil {
+		if result.Error.Message != nil {
+			return fmt.Errorf("running the What-If operation for the Template Deployment: %s", *result.Error.Message)
+		}
+		return fmt.Errorf("running the What-If operation for the Template Deployment: %+v", *result.Error)
+	}
+
+	whatIf := whatIfRaw[0].(map[string]interface{})
+	changes := flattenTemplateDeploymentWhatIfChanges(result.WhatIfOperationProperties, *utils.ExpandStringSlice(whatIf["ignored_properties"].([]interface{})), *utils.ExpandStringSlice(whatIf["ignored_resource_types"].([]interface{})))
+
+	// the Plugin SDK doesn't support returning warnings when planning, so the predicted changes are logged as warnings
+	// and exposed in `what_if_changes` so that they're visible in the plan
+	for _, v := range changes {
+		change := v.(map[string]interface{})
+		log.Printf("[WARN] What-If predicts the Template Deployment will %s %q (changed properties: %v)", strings.ToLower(change["change_type"].(string)), change["resource_id"].(string), change["changed_properties"])
+	}
+
+	return d.SetNew("what_if_changes", changes)
+}
+
+func flattenTemplateDeploymentWhatIfChanges(input *resources.WhatIfOperationProperties, ignoredProperties []string, ignoredResourceTypes []string) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.Changes == nil {
+		return output
+	}
+
+	changes := *input.Changes
+	sort.SliceStable(changes, func(i, j int) bool {
+		return strings.ToLower(pointer.From(changes[i].ResourceID)) < strings.ToLower(pointer.From(changes[j].ResourceID))
+	})
+
+	for _, change := range changes {
+		if _, ok := templateDeploymentWhatIfReportedChangeTypes[change.ChangeType]; !ok {
+			continue
+		}
+
+		resourceId := pointer.From(change.ResourceID)
+		if templateDeploymentWhatIfResourceTypeIsIgnored(resourceId, ignoredResourceTypes) {
+			continue
+		}
+
+		changedProperties := make([]string, 0)
+		if change.ChangeType == resources.ChangeTypeModify {
+			for _, path := range flattenTemplateDeploymentWhatIfPropertyPaths("", false, change.Delta) {
+				if !templateDeploymentWhatIfPropertyIsIgnored(path, ignoredProperties) {
+					changedProperties = append(changedProperties, path)
+				}
+			}
+
+			// a modification which only changes ignored properties isn't reported
+			if len(changedProperties) == 0 {
+				continue
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"resource_id":        resourceId,
+			"change_type":        string(change.ChangeType),
+			"changed_properties": utils.FlattenStringSlice(&changedProperties),
+		})
+	}
+
+	return output
+}
+
+// flattenTemplateDeploymentWhatIfPropertyPaths returns the paths of the properties which are changed, where the
+// children of arrays are referenced by their index, e.g. `properties.ipConfigurations[0].name`
+func flattenTemplateDeploymentWhatIfPropertyPaths(parent string, parentIsArray bool, input *[]resources.WhatIfPropertyChange) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, change := range *input {
+		path := pointer.From(change.Path)
+		switch {
+		case parent == "":
+		case parentIsArray:
+			path = fmt.Sprintf("%s[%s]", parent, path)
+		default:
+			path = fmt.Sprintf("%s.%s", parent, path)
+		}
+
+		if change.Children != nil && len(*change.Children) > 0 {
+			output = append(output, flattenTemplateDeploymentWhatIfPropertyPaths(path, change.PropertyChangeType == resources.PropertyChangeTypeArray, change.Children)...)
+			continue
+		}
+
+		output = append(output, path)
+	}
+
+	return output
+}
+
+// templateDeploymentWhatIfPropertyIsIgnored returns whether the property path matches, or is nested within, one of
+// the ignored property paths
+func templateDeploymentWhatIfPropertyIsIgnored(path string, ignoredProperties []string) bool {
+	path = strings.ToLower(path)
+	for _, ignored := range ignoredProperties {
+		ignored = strings.ToLower(ignored)
+		if path == ignored || strings.HasPrefix(path, ignored+".") || strings.HasPrefix(path, ignored+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// templateDeploymentWhatIfResourceTypeIsIgnored returns whether the type of the resource, e.g.
+// `Microsoft.Network/virtualNetworks/subnets`, matches one of the ignored resource types
+func templateDeploymentWhatIfResourceTypeIsIgnored(resourceId string, ignoredResourceTypes []string) bool {
+	if len(ignoredResourceTypes) == 0 {
+		return false
+	}
+
+	resourceType := templateDeploymentWhatIfResourceType(resourceId)
+	for _, ignored := range ignoredResourceTypes {
+		if strings.EqualFold(resourceType, ignored) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// templateDeploymentWhatIfResourceType returns the fully qualified type of the resource from its ID, using the
+// segments following the last `providers` segment
+func templateDeploymentWhatIfResourceType(resourceId string) string {
+	index := strings.LastIndex(strings.ToLower(resourceId), "/providers/")
+	if index == -1 {
+		return ""
+	}
+
+	segments := strings.Split(strings.Trim(resourceId[index+len("/providers/"):], "/"), "/")
+	if len(segments) == 0 {
+		return ""
+	}
+
+	// the segments alternate between the type and the name, following the namespace of the resource provider
+	types := []string{segments[0]}
+	for i := 1; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+
+	return strings.Join(types, "/")
+}
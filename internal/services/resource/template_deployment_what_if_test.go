@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestTemplateDeploymentWhatIfResourceType(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Expected: "",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/pip1",
+			Expected: "Microsoft.Network/publicIPAddresses",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/vnet1/subnets/subnet1",
+			Expected: "Microsoft.Network/virtualNetworks/subnets",
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyDefinitions/policy1",
+			Expected: "Microsoft.Authorization/policyDefinitions",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual := templateDeploymentWhatIfResourceType(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestTemplateDeploymentWhatIfPropertyIsIgnored(t *testing.T) {
+	ignored := []string{"properties.provisioningState", "tags"}
+
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "properties.provisioningState",
+			Expected: true,
+		},
+		{
+			Input:    "Properties.ProvisioningState",
+			Expected: true,
+		},
+		{
+			Input:    "tags.Environment",
+			Expected: true,
+		},
+		{
+			Input:    "tagsExtra",
+			Expected: false,
+		},
+		{
+			Input:    "properties.idleTimeoutInMinutes",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual := templateDeploymentWhatIfPropertyIsIgnored(v.Input, ignored)
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestFlattenTemplateDeploymentWhatIfChanges(t *testing.T) {
+	prefix := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/"
+	input := &resources.WhatIfOperationProperties{
+		Changes: &[]resources.WhatIfChange{
+			{
+				ResourceID: pointer.To(prefix + "Microsoft.Network/virtualNetworks/vnet1"),
+				ChangeType: resources.ChangeTypeModify,
+				Delta: &[]resources.WhatIfPropertyChange{
+					{
+						Path:               pointer.To("properties.provisioningState"),
+						PropertyChangeType: resources.PropertyChangeTypeModify,
+					},
+				},
+			},
+			{
+				ResourceID: pointer.To(prefix + "Microsoft.Network/publicIPAddresses/pip1"),
+				ChangeType: resources.ChangeTypeModify,
+				Delta: &[]resources.WhatIfPropertyChange{
+					{
+						Path:               pointer.To("tags.Hello"),
+						PropertyChangeType: resources.PropertyChangeTypeModify,
+					},
+					{
+						Path:               pointer.To("properties.ipTags"),
+						PropertyChangeType: resources.PropertyChangeTypeArray,
+						Children: &[]resources.WhatIfPropertyChange{
+							{
+								Path:               pointer.To("0"),
+								PropertyChangeType: resources.PropertyChangeTypeModify,
+								Children: &[]resources.WhatIfPropertyChange{
+									{
+										Path:               pointer.To("tag"),
+										PropertyChangeType: resources.PropertyChangeTypeModify,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				ResourceID: pointer.To(prefix + "Microsoft.Storage/storageAccounts/account1"),
+				ChangeType: resources.ChangeTypeNoChange,
+			},
+			{
+				ResourceID: pointer.To(prefix + "Microsoft.Network/networkSecurityGroups/nsg1"),
+				ChangeType: resources.ChangeTypeCreate,
+			},
+			{
+				ResourceID: pointer.To(prefix + "Microsoft.Network/virtualNetworks/vnet1/subnets/subnet1"),
+				ChangeType: resources.ChangeTypeDelete,
+			},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"resource_id":        prefix + "Microsoft.Network/networkSecurityGroups/nsg1",
+			"change_type":        "Create",
+			"changed_properties": []interface{}{},
+		},
+		map[string]interface{}{
+			"resource_id":        prefix + "Microsoft.Network/publicIPAddresses/pip1",
+			"change_type":        "Modify",
+			"changed_properties": []interface{}{"tags.Hello", "properties.ipTags[0].tag"},
+		},
+	}
+
+	actual := flattenTemplateDeploymentWhatIfChanges(input, []string{"properties.provisioningState"}, []string{"Microsoft.Network/virtualNetworks/subnets"})
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
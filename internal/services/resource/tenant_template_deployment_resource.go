@@ -92,6 +92,8 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 
+			"what_if": templateDeploymentWhatIfSchema(),
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -99,10 +101,41 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(tenantTemplateDeploymentCustomizeDiffWhatIf),
 	}
 }
 
+func tenantTemplateDeploymentCustomizeDiffWhatIf(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
+
+	deploymentFields := []string{"name", "location", "template_content", "template_spec_version_id", "debug_level", "parameters_content", "tags"}
+
+	return customizeDiffTemplateDeploymentWhatIf(ctx, d, resources.DeploymentModeIncremental, deploymentFields, func(ctx context.Context, properties resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+		deploymentName := d.Get("name").(string)
+
+		future, err := client.WhatIfAtTenantScope(ctx, deploymentName, resources.ScopedDeploymentWhatIf{
+			Location:   pointer.To(location.Normalize(d.Get("location").(string))),
+			Properties: &properties,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return nil, fmt.Errorf("waiting for the What-If operation for Tenant Template Deployment %q: %+v", deploymentName, err)
+		}
+		result, err := future.Result(*client)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving the result of the What-If operation for Tenant Template Deployment %q: %+v", deploymentName, err)
+		}
+
+		return &result, nil
+	})
+}
+
 func tenantTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -185,6 +218,11 @@ func tenantTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
+	// the `what_if` block only affects planning, so changes to it alone don't require the deployment to be run again
+	if !d.HasChangesExcept("what_if", "what_if_changes") {
+		return tenantTemplateDeploymentResourceRead(d, meta)
+	}
+
 	template, err := client.GetAtTenantScope(ctx, id.DeploymentName)
 	if err != nil {
 		return fmt.Errorf("retrieving Tenant Template Deployment %q: %+v", id.DeploymentName, err)
@@ -5,8 +5,10 @@ package securitycenter
 
 import (
 	"fmt"
+	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/security/2022-05-01/settings"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -112,6 +114,11 @@ func resourceSecurityCenterSettingRead(d *pluginsdk.ResourceData, meta interface
 
 	resp, err := client.Get(ctx, *id)
 	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s does not exist - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type ServiceBusAuthorizationRuleKeysEphemeral struct{}
+
+func TestAccEphemeralServiceBusAuthorizationRuleKeys_namespace(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_servicebus_authorization_rule_keys", "test")
+	r := ServiceBusAuthorizationRuleKeysEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.namespace(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("primary_key"), knownvalue.StringRegexp(regexp.MustCompile(".+"))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("primary_connection_string"), knownvalue.StringRegexp(regexp.MustCompile("^Endpoint=sb://.+;SharedAccessKeyName=.+;SharedAccessKey=.+"))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccEphemeralServiceBusAuthorizationRuleKeys_queue(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_servicebus_authorization_rule_keys", "test")
+	r := ServiceBusAuthorizationRuleKeysEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.queue(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("primary_key"), knownvalue.StringRegexp(regexp.MustCompile(".+"))),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("primary_connection_string"), knownvalue.StringRegexp(regexp.MustCompile("^Endpoint=sb://.+;SharedAccessKeyName=.+;SharedAccessKey=.+"))),
+				},
+			},
+		},
+	})
+}
+
+func (ServiceBusAuthorizationRuleKeysEphemeral) namespace(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_servicebus_authorization_rule_keys" "test" {
+  authorization_rule_id = azurerm_servicebus_namespace_authorization_rule.test.id
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_servicebus_authorization_rule_keys.test
+}
+
+resource "echo" "test" {}
+`, ServiceBusNamespaceAuthorizationRuleResource{}.base(data, true, true, false))
+}
+
+func (ServiceBusAuthorizationRuleKeysEphemeral) queue(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_servicebus_authorization_rule_keys" "test" {
+  authorization_rule_id = azurerm_servicebus_queue_authorization_rule.test.id
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_servicebus_authorization_rule_keys.test
+}
+
+resource "echo" "test" {}
+`, ServiceBusQueueAuthorizationRuleResource{}.base(data, true, true, false))
+}
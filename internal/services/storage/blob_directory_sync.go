@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+)
+
+type blobDirectorySyncFile struct {
+	BlobName   string
+	Path       string
+	Size       int64
+	ContentMD5 string
+}
+
+type BlobDirectorySync struct {
+	Client *blobs.Client
+
+	ContainerName string
+	CacheControl  string
+	Parallelism   int
+}
+
+// blobDirectorySyncLocalFiles walks sourceDirectory and returns the regular files within it keyed by the name of the
+// blob they are synced to, along with the base64 encoded MD5 of their content
+func blobDirectorySyncLocalFiles(sourceDirectory, prefix string) (map[string]blobDirectorySyncFile, error) {
+	files := make(map[string]blobDirectorySyncFile)
+
+	err := filepath.WalkDir(sourceDirectory, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(sourceDirectory, path)
+		if err != nil {
+			return fmt.Errorf("determining relative path of %q: %+v", path, err)
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("retrieving file info for %q: %+v", path, err)
+		}
+
+		contentMD5, err := directorySyncFileMD5(path)
+		if err != nil {
+			return err
+		}
+
+		blobName := prefix + filepath.ToSlash(relativePath)
+		files[blobName] = blobDirectorySyncFile{
+			BlobName:   blobName,
+			Path:       path,
+			Size:       info.Size(),
+			ContentMD5: contentMD5,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking %q: %+v", sourceDirectory, err)
+	}
+
+	return files, nil
+}
+
+func blobDirectorySyncContentMD5s(files map[string]blobDirectorySyncFile) map[string]string {
+	result := make(map[string]string, len(files))
+	for name, file := range files {
+		result[name] = file.ContentMD5
+	}
+	return result
+}
+
+// Upload uploads the specified files, up to Parallelism files are uploaded concurrently
+func (s BlobDirectorySync) Upload(ctx context.Context, files []blobDirectorySyncFile) error {
+	return runInParallel(s.Parallelism, len(files), func(i int) error {
+		return s.uploadFile(ctx, files[i])
+	})
+}
+
+// Delete deletes the specified blobs along with any snapshots, up to Parallelism blobs are deleted concurrently
+func (s BlobDirectorySync) Delete(ctx context.Context, blobNames []string) error {
+	return runInParallel(s.Parallelism, len(blobNames), func(i int) error {
+		if _, err := s.Client.Delete(ctx, s.ContainerName, blobNames[i], blobs.DeleteInput{DeleteSnapshots: true}); err != nil {
+			return fmt.Errorf("deleting blob %q: %+v", blobNames[i], err)
+		}
+		return nil
+	})
+}
+
+func (s BlobDirectorySync) uploadFile(ctx context.Context, source blobDirectorySyncFile) error {
+	file, err := os.Open(source.Path)
+	if err != nil {
+		return fmt.Errorf("opening %q: %+v", source.Path, err)
+	}
+	defer file.Close()
+
+	contentType, err := directorySyncContentType(file)
+	if err != nil {
+		return err
+	}
+
+	var cacheControl *string
+	if s.CacheControl != "" {
+		cacheControl = pointer.To(s.CacheControl)
+	}
+
+	// files larger than a single block are uploaded as blocks in parallel, otherwise a single Put Blob request is used
+	if source.Size > blockBlobBlockSize {
+		blockIds, err := putBlocksFromSource(ctx, s.Client, s.ContainerName, source.BlobName, "", file, source.Size, s.Parallelism)
+		if err != nil {
+			return fmt.Errorf("uploading blocks for blob %q: %+v", source.BlobName, err)
+		}
+
+		input := blobs.PutBlockListInput{
+			BlockList: blobs.BlockList{
+				LatestBlockIDs: blockIds,
+			},
+			CacheControl: cacheControl,
+			ContentMD5:   pointer.To(source.ContentMD5),
+			ContentType:  pointer.To(contentType),
+		}
+		if _, err := s.Client.PutBlockList(ctx, s.ContainerName, source.BlobName, input); err != nil {
+			return fmt.Errorf("committing block list for blob %q: %+v", source.BlobName, err)
+		}
+
+		return nil
+	}
+
+	input := blobs.PutBlockBlobInput{
+		CacheControl: cacheControl,
+		ContentMD5:   pointer.To(source.ContentMD5),
+		ContentType:  pointer.To(contentType),
+	}
+	if source.Size > 0 {
+		content := make([]byte, source.Size)
+		if _, err := file.ReadAt(content, 0); err != nil && err != io.EOF {
+			return fmt.Errorf("reading %q: %+v", source.Path, err)
+		}
+		input.Content = &content
+	}
+
+	if _, err := s.Client.PutBlockBlob(ctx, s.ContainerName, source.BlobName, input); err != nil {
+		return fmt.Errorf("uploading blob %q: %+v", source.BlobName, err)
+	}
+
+	return nil
+}
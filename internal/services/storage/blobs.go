@@ -6,6 +6,7 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
@@ -162,6 +163,15 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("could not stat file %q: %s", file.Name(), err)
+	}
+
+	if info.Size() > blockBlobBlockSize {
+		return sbu.blockUploadFromSource(ctx, file, info.Size())
+	}
+
 	input := blobs.PutBlockBlobInput{
 		ContentType: pointer.To(sbu.ContentType),
 		MetaData:    sbu.MetaData,
@@ -179,6 +189,42 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	return nil
 }
 
+// blockUploadFromSource uploads the file as a set of blocks which are committed once they've all been uploaded. Unlike
+// a single Put Blob request Azure doesn't compute the MD5 of a blob committed from a block list, so when one isn't
+// specified it's computed from the file
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, file *os.File, fileSize int64) error {
+	contentMD5 := sbu.ContentMD5
+	if contentMD5 == "" {
+		hash := md5.New()
+		if _, err := io.Copy(hash, io.NewSectionReader(file, 0, fileSize)); err != nil {
+			return fmt.Errorf("computing MD5 of source file %q: %s", sbu.Source, err)
+		}
+		contentMD5 = base64.StdEncoding.EncodeToString(hash.Sum(nil))
+	}
+
+	blockIds, err := putBlocksFromSource(ctx, sbu.Client, sbu.ContainerName, sbu.BlobName, sbu.EncryptionScope, file, fileSize, sbu.Parallelism)
+	if err != nil {
+		return fmt.Errorf("uploading source file %q: %s", sbu.Source, err)
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIds,
+		},
+		ContentMD5:  pointer.To(contentMD5),
+		ContentType: pointer.To(sbu.ContentType),
+		MetaData:    sbu.MetaData,
+	}
+	if sbu.EncryptionScope != "" {
+		input.EncryptionScope = pointer.To(sbu.EncryptionScope)
+	}
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("PutBlockList: %s", err)
+	}
+
+	return nil
+}
+
 func (sbu BlobUpload) createEmptyPageBlob(ctx context.Context) error {
 	if sbu.Size == 0 {
 		return errors.New("`size` cannot be zero for a page blob")
@@ -303,6 +349,100 @@ func (sbu BlobUpload) pageUploadFromSource(ctx context.Context, file io.ReaderAt
 	return nil
 }
 
+// blockBlobBlockSize is the size of the blocks that large files are split into when uploaded as a Block blob
+const blockBlobBlockSize int64 = 4 * 1024 * 1024
+
+// putBlocksFromSource splits the file into fixed-size blocks and uploads up to `parallelism` of them concurrently,
+// returning the IDs of the blocks in the order they should be committed
+func putBlocksFromSource(ctx context.Context, client *blobs.Client, containerName, blobName, encryptionScope string, file io.ReaderAt, fileSize int64, parallelism int) ([]blobs.BlockID, error) {
+	blockCount := int((fileSize + blockBlobBlockSize - 1) / blockBlobBlockSize)
+
+	// Block IDs must be base64 encoded and of equal length within a blob
+	blockIds := make([]blobs.BlockID, blockCount)
+	for i := range blockIds {
+		blockIds[i] = blobs.BlockID{
+			Value: base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%08d", i))),
+		}
+	}
+
+	err := runInParallel(parallelism, blockCount, func(i int) error {
+		offset := int64(i) * blockBlobBlockSize
+		size := blockBlobBlockSize
+		if remaining := fileSize - offset; remaining < size {
+			size = remaining
+		}
+
+		content := make([]byte, size)
+		if _, err := file.ReadAt(content, offset); err != nil && err != io.EOF {
+			return fmt.Errorf("reading at offset %d: %s", offset, err)
+		}
+
+		input := blobs.PutBlockInput{
+			BlockID: blockIds[i].Value,
+			Content: content,
+		}
+		if encryptionScope != "" {
+			input.EncryptionScope = pointer.To(encryptionScope)
+		}
+		if _, err := client.PutBlock(ctx, containerName, blobName, input); err != nil {
+			return fmt.Errorf("writing block at offset %d: %s", offset, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return blockIds, nil
+}
+
+// runInParallel calls fn for each index in [0, count) using up to `parallelism` workers, returning an error which
+// combines all failed calls
+func runInParallel(parallelism, count int, fn func(i int) error) error {
+	workerCount := parallelism
+	if workerCount < 1 {
+		workerCount = 1
+	}
+	if workerCount > count {
+		workerCount = count
+	}
+
+	indexes := make(chan int, count)
+	for i := 0; i < count; i++ {
+		indexes <- i
+	}
+	close(indexes)
+
+	errs := make(chan error, count)
+	wg := &sync.WaitGroup{}
+	wg.Add(workerCount)
+
+	for i := 0; i < workerCount; i++ {
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				if err := fn(index); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	var messages []string
+	for err := range errs {
+		messages = append(messages, err.Error())
+	}
+	if len(messages) > 0 {
+		return fmt.Errorf("%d of %d operations failed: %s", len(messages), count, strings.Join(messages, "; "))
+	}
+
+	return nil
+}
+
 const (
 	minPageSize int64 = 4 * 1024
 
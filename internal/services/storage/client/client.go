@@ -33,6 +33,7 @@ type Client struct {
 
 	StorageUseAzureAD bool
 
+	authConfig           *auth.Credentials
 	authConfigForAzureAD *auth.Credentials
 }
 
@@ -93,6 +94,8 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		SyncGroupsClient:           syncGroupsClient,
 
 		StorageDomainSuffix: *storageSuffix,
+
+		authConfig: o.AuthConfig,
 	}
 
 	if o.StorageUseAzureAD {
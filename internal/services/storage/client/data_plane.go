@@ -44,10 +44,23 @@ func (Client) DataPlaneOperationSupportingOnlySharedKeyAuth() DataPlaneOperation
 	}
 }
 
+func (Client) DataPlaneOperationSupportingOnlyAadAuth() DataPlaneOperation {
+	return DataPlaneOperation{
+		SupportsAadAuthentication:       true,
+		SupportsSharedKeyAuthentication: false,
+	}
+}
+
 func (c *Client) configureDataPlane(ctx context.Context, clientName, resourceIdentifier string, baseClient client.BaseClient, account AccountDetails, operation DataPlaneOperation) error {
-	if operation.SupportsAadAuthentication && c.authConfigForAzureAD != nil {
-		api := c.authConfigForAzureAD.Environment.Storage.WithResourceIdentifier(resourceIdentifier)
-		storageAuth, err := auth.NewAuthorizerFromCredentials(ctx, *c.authConfigForAzureAD, api)
+	authConfig := c.authConfigForAzureAD
+	if !operation.SupportsSharedKeyAuthentication {
+		// operations which can only be authenticated using Entra ID use the Provider credentials regardless of `storage_use_azuread`
+		authConfig = c.authConfig
+	}
+
+	if operation.SupportsAadAuthentication && authConfig != nil {
+		api := authConfig.Environment.Storage.WithResourceIdentifier(resourceIdentifier)
+		storageAuth, err := auth.NewAuthorizerFromCredentials(ctx, *authConfig, api)
 		if err != nil {
 			return fmt.Errorf("unable to build authorizer for Storage API: %+v", err)
 		}
@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUserDelegationKey_ComputeSASToken(t *testing.T) {
+	key := UserDelegationKey{
+		SignedOid:     "00000000-0000-0000-0000-000000000001",
+		SignedTid:     "00000000-0000-0000-0000-000000000002",
+		SignedStart:   "2024-01-01T00:00:00Z",
+		SignedExpiry:  "2024-01-02T00:00:00Z",
+		SignedService: "b",
+		SignedVersion: "2022-11-02",
+		Value:         "2vJrjEyL4re2nxCEg590wJUUC7PiqqrDHjAN5RU304FNUQieiEwS2bfp83O0v28iSfWjvYhkGmjYQAdd9x+6nw==",
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiry := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name        string
+		BlobName    string
+		Permissions string
+		Expected    string
+	}{
+		{
+			Name:        "Container",
+			Permissions: "rl",
+			Expected:    "?se=2024-01-02T00%3A00%3A00Z&sig=7uwfJGWvEFJuHYMfXeXccVoPAp2Lwv7qTt6J7fKAnnM%3D&ske=2024-01-02T00%3A00%3A00Z&skoid=00000000-0000-0000-0000-000000000001&sks=b&skt=2024-01-01T00%3A00%3A00Z&sktid=00000000-0000-0000-0000-000000000002&skv=2022-11-02&sp=rl&spr=https&sr=c&st=2024-01-01T00%3A00%3A00Z&sv=2022-11-02",
+		},
+		{
+			Name:        "Blob",
+			BlobName:    "file.txt",
+			Permissions: "r",
+			Expected:    "?se=2024-01-02T00%3A00%3A00Z&sig=mlQ6gYTCcSf%2FQnLvYb8cx%2BGdFeta45Me%2FofEDhGVXFU%3D&ske=2024-01-02T00%3A00%3A00Z&skoid=00000000-0000-0000-0000-000000000001&sks=b&skt=2024-01-01T00%3A00%3A00Z&sktid=00000000-0000-0000-0000-000000000002&skv=2022-11-02&sp=r&spr=https&sr=b&st=2024-01-01T00%3A00%3A00Z&sv=2022-11-02",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		actual, err := key.ComputeSASToken("azurermtestsa0", "content", tc.BlobName, tc.Permissions, start, expiry)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,330 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/datalakestore/paths"
+	"github.com/jackofallops/giovanni/storage/accesscontrol"
+)
+
+type DataLakeGen2RecursiveAclMode string
+
+const (
+	DataLakeGen2RecursiveAclModeModify DataLakeGen2RecursiveAclMode = "modify"
+	DataLakeGen2RecursiveAclModeRemove DataLakeGen2RecursiveAclMode = "remove"
+	DataLakeGen2RecursiveAclModeSet    DataLakeGen2RecursiveAclMode = "set"
+)
+
+type dataLakeGen2RecursiveAclFailure struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+type dataLakeGen2RecursiveAclResult struct {
+	DirectoriesSuccessful int64                             `json:"directoriesSuccessful"`
+	FilesSuccessful       int64                             `json:"filesSuccessful"`
+	FailureCount          int64                             `json:"failureCount"`
+	FailedEntries         []dataLakeGen2RecursiveAclFailure `json:"failedEntries"`
+}
+
+// dataLakeGen2ListPathsMaxResults is the maximum number of paths which can be returned by a single List Paths request
+const dataLakeGen2ListPathsMaxResults = 5000
+
+type dataLakeGen2PathEntry struct {
+	Name        string `json:"name"`
+	IsDirectory string `json:"isDirectory"`
+}
+
+type dataLakeGen2ListPathsResult struct {
+	Paths []dataLakeGen2PathEntry `json:"paths"`
+}
+
+// DataLakeGen2RecursiveAcl applies Access Control Lists to a Data Lake Gen2 Path and everything beneath it, using the
+// `setAccessControlRecursive` operation which isn't currently supported by Giovanni. An empty Path refers to the root of
+// the filesystem.
+type DataLakeGen2RecursiveAcl struct {
+	Client *paths.Client
+
+	FileSystemName    string
+	Path              string
+	BatchSize         int
+	ContinueOnFailure bool
+}
+
+// Apply applies the ACL in batches of BatchSize paths, following the continuation token until the whole tree has been
+// processed. The paths which couldn't be updated are returned rather than treated as an error.
+func (a DataLakeGen2RecursiveAcl) Apply(ctx context.Context, mode DataLakeGen2RecursiveAclMode, acl string) ([]dataLakeGen2RecursiveAclFailure, error) {
+	failures := make([]dataLakeGen2RecursiveAclFailure, 0)
+
+	continuation := ""
+	for {
+		opts := client.RequestOptions{
+			ContentType: "application/json",
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod: http.MethodPatch,
+			OptionsObject: dataLakeGen2RecursiveAclOptions{
+				acl:               acl,
+				mode:              mode,
+				batchSize:         a.BatchSize,
+				continuation:      continuation,
+				continueOnFailure: a.ContinueOnFailure,
+			},
+			Path: fmt.Sprintf("/%s/%s", a.FileSystemName, a.Path),
+		}
+
+		req, err := a.Client.Client.NewRequest(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("building request: %+v", err)
+		}
+
+		resp, err := req.Execute(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("executing request: %+v", err)
+		}
+
+		var result dataLakeGen2RecursiveAclResult
+		if err := resp.Unmarshal(&result); err != nil {
+			return nil, fmt.Errorf("unmarshalling response: %+v", err)
+		}
+		failures = append(failures, result.FailedEntries...)
+
+		// without the force flag the operation stops at the first batch containing a failure
+		if !a.ContinueOnFailure && result.FailureCount > 0 {
+			break
+		}
+
+		continuation = resp.Header.Get("x-ms-continuation")
+		if continuation == "" {
+			break
+		}
+	}
+
+	return failures, nil
+}
+
+// ListPaths returns up to limit files and directories beneath the Path
+func (a DataLakeGen2RecursiveAcl) ListPaths(ctx context.Context, limit int) ([]dataLakeGen2PathEntry, error) {
+	result := make([]dataLakeGen2PathEntry, 0)
+
+	continuation := ""
+	for len(result) < limit {
+		opts := client.RequestOptions{
+			ContentType: "application/json",
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod: http.MethodGet,
+			OptionsObject: dataLakeGen2ListPathsOptions{
+				directory:    a.Path,
+				continuation: continuation,
+				maxResults:   min(limit-len(result), dataLakeGen2ListPathsMaxResults),
+			},
+			Path: fmt.Sprintf("/%s", a.FileSystemName),
+		}
+
+		req, err := a.Client.Client.NewRequest(ctx, opts)
+		if err != nil {
+			return nil, fmt.Errorf("building request: %+v", err)
+		}
+
+		resp, err := req.Execute(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("executing request: %+v", err)
+		}
+
+		var page dataLakeGen2ListPathsResult
+		if err := resp.Unmarshal(&page); err != nil {
+			return nil, fmt.Errorf("unmarshalling response: %+v", err)
+		}
+		result = append(result, page.Paths...)
+
+		continuation = resp.Header.Get("x-ms-continuation")
+		if continuation == "" {
+			break
+		}
+	}
+
+	if len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result, nil
+}
+
+// dataLakeGen2AclRemovalString returns the named entries of the ACL in the format expected when removing entries, which
+// omits the permissions. Entries without a qualifier are required on every path so can't be removed.
+func dataLakeGen2AclRemovalString(acl accesscontrol.ACL) string {
+	entries := make([]string, 0)
+	for _, ace := range acl.Entries {
+		if ace.TagQualifier == nil {
+			continue
+		}
+
+		entry := fmt.Sprintf("%s:%s", ace.TagType, ace.TagQualifier.String())
+		if ace.IsDefault {
+			entry = "default:" + entry
+		}
+		entries = append(entries, entry)
+	}
+
+	return strings.Join(entries, ",")
+}
+
+// dataLakeGen2AclDiverges determines whether the actual ACL of a path differs from the declared entries. Default entries
+// only apply to directories. When the mode is `set` any additional named entries are also considered divergent, whereas
+// the entries without a qualifier are always present so are only compared when declared.
+func dataLakeGen2AclDiverges(declared, actual accesscontrol.ACL, isDirectory bool, mode DataLakeGen2RecursiveAclMode) bool {
+	applicable := func(ace accesscontrol.ACE) bool {
+		return isDirectory || !ace.IsDefault
+	}
+
+	for _, expected := range declared.Entries {
+		if !applicable(expected) {
+			continue
+		}
+
+		found := false
+		for _, existing := range actual.Entries {
+			if dataLakeGen2SameAclEntry(expected, existing) {
+				found = existing.Permissions == expected.Permissions
+				break
+			}
+		}
+		if !found {
+			return true
+		}
+	}
+
+	if mode != DataLakeGen2RecursiveAclModeSet {
+		return false
+	}
+
+	for _, existing := range actual.Entries {
+		if existing.TagQualifier == nil {
+			continue
+		}
+
+		found := false
+		for _, expected := range declared.Entries {
+			if applicable(expected) && dataLakeGen2SameAclEntry(expected, existing) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dataLakeGen2AclHasUndeclaredEntries determines whether the actual ACL of a path contains named entries which aren't
+// declared with the same permissions, indicating that the ACL is already being managed
+func dataLakeGen2AclHasUndeclaredEntries(declared, actual accesscontrol.ACL) bool {
+	for _, existing := range actual.Entries {
+		if existing.TagQualifier == nil {
+			continue
+		}
+
+		found := false
+		for _, expected := range declared.Entries {
+			if dataLakeGen2SameAclEntry(expected, existing) {
+				found = existing.Permissions == expected.Permissions
+				break
+			}
+		}
+		if !found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dataLakeGen2SameAclEntry determines whether two ACEs are for the same entry, regardless of their permissions
+func dataLakeGen2SameAclEntry(a, b accesscontrol.ACE) bool {
+	if a.TagType != b.TagType || a.IsDefault != b.IsDefault {
+		return false
+	}
+	if a.TagQualifier == nil || b.TagQualifier == nil {
+		return a.TagQualifier == nil && b.TagQualifier == nil
+	}
+	return *a.TagQualifier == *b.TagQualifier
+}
+
+type dataLakeGen2RecursiveAclOptions struct {
+	acl               string
+	mode              DataLakeGen2RecursiveAclMode
+	batchSize         int
+	continuation      string
+	continueOnFailure bool
+}
+
+func (o dataLakeGen2RecursiveAclOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("x-ms-acl", o.acl)
+	return headers
+}
+
+func (o dataLakeGen2RecursiveAclOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o dataLakeGen2RecursiveAclOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("action", "setAccessControlRecursive")
+	out.Append("mode", string(o.mode))
+	if o.batchSize > 0 {
+		out.Append("maxRecords", strconv.Itoa(o.batchSize))
+	}
+	if o.continuation != "" {
+		out.Append("continuation", o.continuation)
+	}
+	if o.continueOnFailure {
+		out.Append("forceFlag", "true")
+	}
+	return out
+}
+
+type dataLakeGen2ListPathsOptions struct {
+	directory    string
+	continuation string
+	maxResults   int
+}
+
+func (o dataLakeGen2ListPathsOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o dataLakeGen2ListPathsOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o dataLakeGen2ListPathsOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("resource", "filesystem")
+	out.Append("recursive", "true")
+	if o.directory != "" {
+		out.Append("directory", o.directory)
+	}
+	if o.continuation != "" {
+		out.Append("continuation", o.continuation)
+	}
+	if o.maxResults > 0 {
+		out.Append("maxResults", strconv.Itoa(o.maxResults))
+	}
+	return out
+}
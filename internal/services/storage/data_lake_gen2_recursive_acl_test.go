@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/jackofallops/giovanni/storage/accesscontrol"
+)
+
+func TestDataLakeGen2AclDiverges(t *testing.T) {
+	testcases := []struct {
+		Name        string
+		Declared    string
+		Actual      string
+		IsDirectory bool
+		Mode        DataLakeGen2RecursiveAclMode
+		Diverges    bool
+	}{
+		{
+			Name:        "Matching Named Entry",
+			Declared:    "user:11111111-1111-1111-1111-111111111111:r-x",
+			Actual:      "user::rwx,user:11111111-1111-1111-1111-111111111111:r-x,group::r-x,mask::r-x,other::---",
+			IsDirectory: true,
+			Mode:        DataLakeGen2RecursiveAclModeModify,
+			Diverges:    false,
+		},
+		{
+			Name:        "Missing Named Entry",
+			Declared:    "user:11111111-1111-1111-1111-111111111111:r-x",
+			Actual:      "user::rwx,group::r-x,other::---",
+			IsDirectory: true,
+			Mode:        DataLakeGen2RecursiveAclModeModify,
+			Diverges:    true,
+		},
+		{
+			Name:        "Different Permissions",
+			Declared:    "user:11111111-1111-1111-1111-111111111111:r-x",
+			Actual:      "user::rwx,user:11111111-1111-1111-1111-111111111111:rwx,group::r-x,mask::rwx,other::---",
+			IsDirectory: false,
+			Mode:        DataLakeGen2RecursiveAclModeModify,
+			Diverges:    true,
+		},
+		{
+			Name:        "Default Entry Ignored For Files",
+			Declared:    "user:11111111-1111-1111-1111-111111111111:r-x,default:user:11111111-1111-1111-1111-111111111111:r-x",
+			Actual:      "user::rw-,user:11111111-1111-1111-1111-111111111111:r-x,group::r--,mask::r-x,other::---",
+			IsDirectory: false,
+			Mode:        DataLakeGen2RecursiveAclModeModify,
+			Diverges:    false,
+		},
+		{
+			Name:        "Default Entry Missing For Directory",
+			Declared:    "user:11111111-1111-1111-1111-111111111111:r-x,default:user:11111111-1111-1111-1111-111111111111:r-x",
+			Actual:      "user::rwx,user:11111111-1111-1111-1111-111111111111:r-x,group::r-x,mask::r-x,other::---",
+			IsDirectory: true,
+			Mode:        DataLakeGen2RecursiveAclModeModify,
+			Diverges:    true,
+		},
+		{
+			Name:        "Additional Named Entry When Modifying",
+			Declared:    "user:11111111-1111-1111-1111-111111111111:r-x",
+			Actual:      "user::rwx,user:11111111-1111-1111-1111-111111111111:r-x,group:22222222-2222-2222-2222-222222222222:r--,group::r-x,mask::r-x,other::---",
+			IsDirectory: true,
+			Mode:        DataLakeGen2RecursiveAclModeModify,
+			Diverges:    false,
+		},
+		{
+			Name:        "Additional Named Entry When Setting",
+			Declared:    "user::rwx,user:11111111-1111-1111-1111-111111111111:r-x,group::r-x,mask::r-x,other::---",
+			Actual:      "user::rwx,user:11111111-1111-1111-1111-111111111111:r-x,group:22222222-2222-2222-2222-222222222222:r--,group::r-x,mask::r-x,other::---",
+			IsDirectory: true,
+			Mode:        DataLakeGen2RecursiveAclModeSet,
+			Diverges:    true,
+		},
+	}
+
+	for _, tc := range testcases {
+		declared, err := accesscontrol.ParseACL(tc.Declared)
+		if err != nil {
+			t.Fatalf("%s: parsing declared ACL: %+v", tc.Name, err)
+		}
+		actual, err := accesscontrol.ParseACL(tc.Actual)
+		if err != nil {
+			t.Fatalf("%s: parsing actual ACL: %+v", tc.Name, err)
+		}
+
+		if diverges := dataLakeGen2AclDiverges(declared, actual, tc.IsDirectory, tc.Mode); diverges != tc.Diverges {
+			t.Errorf("%s: expected diverges to be %t but got %t", tc.Name, tc.Diverges, diverges)
+		}
+	}
+}
+
+func TestDataLakeGen2AclHasUndeclaredEntries(t *testing.T) {
+	testcases := []struct {
+		Name       string
+		Declared   string
+		Actual     string
+		Undeclared bool
+	}{
+		{
+			Name:       "No Named Entries",
+			Declared:   "user:11111111-1111-1111-1111-111111111111:r-x",
+			Actual:     "user::rwx,group::r-x,other::---",
+			Undeclared: false,
+		},
+		{
+			Name:       "Matching Named Entry",
+			Declared:   "user:11111111-1111-1111-1111-111111111111:r-x",
+			Actual:     "user::rwx,user:11111111-1111-1111-1111-111111111111:r-x,group::r-x,mask::r-x,other::---",
+			Undeclared: false,
+		},
+		{
+			Name:       "Different Permissions",
+			Declared:   "user:11111111-1111-1111-1111-111111111111:r-x",
+			Actual:     "user::rwx,user:11111111-1111-1111-1111-111111111111:rwx,group::r-x,mask::rwx,other::---",
+			Undeclared: true,
+		},
+		{
+			Name:       "Additional Named Entry",
+			Declared:   "user:11111111-1111-1111-1111-111111111111:r-x",
+			Actual:     "user::rwx,group:22222222-2222-2222-2222-222222222222:r--,group::r-x,mask::r--,other::---",
+			Undeclared: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		declared, err := accesscontrol.ParseACL(tc.Declared)
+		if err != nil {
+			t.Fatalf("%s: parsing declared ACL: %+v", tc.Name, err)
+		}
+		actual, err := accesscontrol.ParseACL(tc.Actual)
+		if err != nil {
+			t.Fatalf("%s: parsing actual ACL: %+v", tc.Name, err)
+		}
+
+		if undeclared := dataLakeGen2AclHasUndeclaredEntries(declared, actual); undeclared != tc.Undeclared {
+			t.Errorf("%s: expected undeclared to be %t but got %t", tc.Name, tc.Undeclared, undeclared)
+		}
+	}
+}
+
+func TestDataLakeGen2AclRemovalString(t *testing.T) {
+	acl, err := accesscontrol.ParseACL("user::rwx,user:11111111-1111-1111-1111-111111111111:r-x,default:group:22222222-2222-2222-2222-222222222222:r--,mask::r-x,other::---")
+	if err != nil {
+		t.Fatalf("parsing ACL: %+v", err)
+	}
+
+	expected := "user:11111111-1111-1111-1111-111111111111,default:group:22222222-2222-2222-2222-222222222222"
+	if actual := dataLakeGen2AclRemovalString(acl); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// directorySyncFileMD5 returns the base64 encoded MD5 of the content of a local file
+func directorySyncFileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("opening %q: %+v", path, err)
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("hashing %q: %+v", path, err)
+	}
+
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+// directorySyncManifestHash returns a stable hash of a set of names and their values, such as the content MD5 of each
+// synced file, allowing the synced content to be compared without storing the full manifest in state
+func directorySyncManifestHash(values map[string]string) string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	hash := sha256.New()
+	for _, name := range names {
+		hash.Write([]byte(name + "\t" + values[name] + "\n"))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// directorySyncContentType determines the content type of a local file from its extension, falling back to detecting
+// it from the content of the file
+func directorySyncContentType(file *os.File) (string, error) {
+	if contentType := mime.TypeByExtension(filepath.Ext(file.Name())); contentType != "" {
+		return contentType, nil
+	}
+
+	// http.DetectContentType considers at most the first 512 bytes of the content
+	buffer := make([]byte, 512)
+	n, err := file.ReadAt(buffer, 0)
+	if err != nil && err != io.EOF {
+		return "", fmt.Errorf("reading %q: %+v", file.Name(), err)
+	}
+
+	return http.DetectContentType(buffer[:n]), nil
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"testing"
+)
+
+func TestDirectorySyncManifestHash(t *testing.T) {
+	expected := directorySyncManifestHash(map[string]string{
+		"a.txt":     "etag-a",
+		"css/b.css": "etag-b",
+	})
+
+	testData := []struct {
+		Name     string
+		Input    map[string]string
+		Matching bool
+	}{
+		{
+			Name: "same entries",
+			Input: map[string]string{
+				"css/b.css": "etag-b",
+				"a.txt":     "etag-a",
+			},
+			Matching: true,
+		},
+		{
+			Name: "changed value",
+			Input: map[string]string{
+				"a.txt":     "etag-c",
+				"css/b.css": "etag-b",
+			},
+		},
+		{
+			Name: "added entry",
+			Input: map[string]string{
+				"a.txt":     "etag-a",
+				"css/b.css": "etag-b",
+				"c.txt":     "etag-c",
+			},
+		},
+		{
+			Name: "removed entry",
+			Input: map[string]string{
+				"a.txt": "etag-a",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := directorySyncManifestHash(v.Input); (actual == expected) != v.Matching {
+			t.Fatalf("expected the hashes to match to be %t", v.Matching)
+		}
+	}
+}
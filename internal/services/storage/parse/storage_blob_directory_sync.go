@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StorageBlobDirectorySyncId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	StorageAccountName string
+	BlobServiceName    string
+	ContainerName      string
+	DirectorySyncName  string
+}
+
+func NewStorageBlobDirectorySyncID(subscriptionId, resourceGroup, storageAccountName, blobServiceName, containerName, directorySyncName string) StorageBlobDirectorySyncId {
+	return StorageBlobDirectorySyncId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		StorageAccountName: storageAccountName,
+		BlobServiceName:    blobServiceName,
+		ContainerName:      containerName,
+		DirectorySyncName:  directorySyncName,
+	}
+}
+
+func (id StorageBlobDirectorySyncId) String() string {
+	segments := []string{
+		fmt.Sprintf("Directory Sync Name %q", id.DirectorySyncName),
+		fmt.Sprintf("Container Name %q", id.ContainerName),
+		fmt.Sprintf("Blob Service Name %q", id.BlobServiceName),
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Blob Directory Sync", segmentsStr)
+}
+
+func (id StorageBlobDirectorySyncId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/blobServices/%s/containers/%s/directorySyncs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName, id.DirectorySyncName)
+}
+
+// StorageBlobDirectorySyncID parses a StorageBlobDirectorySync ID into an StorageBlobDirectorySyncId struct
+func StorageBlobDirectorySyncID(input string) (*StorageBlobDirectorySyncId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an StorageBlobDirectorySync ID: %+v", input, err)
+	}
+
+	resourceId := StorageBlobDirectorySyncId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.BlobServiceName, err = id.PopSegment("blobServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
+		return nil, err
+	}
+	if resourceId.DirectorySyncName, err = id.PopSegment("directorySyncs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StorageDataLakeGen2PathRecursiveAclId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	StorageAccountName string
+	BlobServiceName    string
+	ContainerName      string
+	RecursiveAclName   string
+}
+
+func NewStorageDataLakeGen2PathRecursiveAclID(subscriptionId, resourceGroup, storageAccountName, blobServiceName, containerName, recursiveAclName string) StorageDataLakeGen2PathRecursiveAclId {
+	return StorageDataLakeGen2PathRecursiveAclId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		StorageAccountName: storageAccountName,
+		BlobServiceName:    blobServiceName,
+		ContainerName:      containerName,
+		RecursiveAclName:   recursiveAclName,
+	}
+}
+
+func (id StorageDataLakeGen2PathRecursiveAclId) String() string {
+	segments := []string{
+		fmt.Sprintf("Recursive Acl Name %q", id.RecursiveAclName),
+		fmt.Sprintf("Container Name %q", id.ContainerName),
+		fmt.Sprintf("Blob Service Name %q", id.BlobServiceName),
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Data Lake Gen2 Path Recursive Acl", segmentsStr)
+}
+
+func (id StorageDataLakeGen2PathRecursiveAclId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/blobServices/%s/containers/%s/recursiveAcls/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName, id.RecursiveAclName)
+}
+
+// StorageDataLakeGen2PathRecursiveAclID parses a StorageDataLakeGen2PathRecursiveAcl ID into an StorageDataLakeGen2PathRecursiveAclId struct
+func StorageDataLakeGen2PathRecursiveAclID(input string) (*StorageDataLakeGen2PathRecursiveAclId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an StorageDataLakeGen2PathRecursiveAcl ID: %+v", input, err)
+	}
+
+	resourceId := StorageDataLakeGen2PathRecursiveAclId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.BlobServiceName, err = id.PopSegment("blobServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
+		return nil, err
+	}
+	if resourceId.RecursiveAclName, err = id.PopSegment("recursiveAcls"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
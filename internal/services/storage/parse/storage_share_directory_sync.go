@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StorageShareDirectorySyncId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	StorageAccountName string
+	FileServiceName    string
+	ShareName          string
+	DirectorySyncName  string
+}
+
+func NewStorageShareDirectorySyncID(subscriptionId, resourceGroup, storageAccountName, fileServiceName, shareName, directorySyncName string) StorageShareDirectorySyncId {
+	return StorageShareDirectorySyncId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		StorageAccountName: storageAccountName,
+		FileServiceName:    fileServiceName,
+		ShareName:          shareName,
+		DirectorySyncName:  directorySyncName,
+	}
+}
+
+func (id StorageShareDirectorySyncId) String() string {
+	segments := []string{
+		fmt.Sprintf("Directory Sync Name %q", id.DirectorySyncName),
+		fmt.Sprintf("Share Name %q", id.ShareName),
+		fmt.Sprintf("File Service Name %q", id.FileServiceName),
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Share Directory Sync", segmentsStr)
+}
+
+func (id StorageShareDirectorySyncId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/fileServices/%s/shares/%s/directorySyncs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.FileServiceName, id.ShareName, id.DirectorySyncName)
+}
+
+// StorageShareDirectorySyncID parses a StorageShareDirectorySync ID into an StorageShareDirectorySyncId struct
+func StorageShareDirectorySyncID(input string) (*StorageShareDirectorySyncId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an StorageShareDirectorySync ID: %+v", input, err)
+	}
+
+	resourceId := StorageShareDirectorySyncId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.FileServiceName, err = id.PopSegment("fileServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.ShareName, err = id.PopSegment("shares"); err != nil {
+		return nil, err
+	}
+	if resourceId.DirectorySyncName, err = id.PopSegment("directorySyncs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StorageTableEntityPartitionId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	StorageAccountName string
+	TableServiceName   string
+	TableName          string
+	PartitionName      string
+}
+
+func NewStorageTableEntityPartitionID(subscriptionId, resourceGroup, storageAccountName, tableServiceName, tableName, partitionName string) StorageTableEntityPartitionId {
+	return StorageTableEntityPartitionId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		StorageAccountName: storageAccountName,
+		TableServiceName:   tableServiceName,
+		TableName:          tableName,
+		PartitionName:      partitionName,
+	}
+}
+
+func (id StorageTableEntityPartitionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Partition Name %q", id.PartitionName),
+		fmt.Sprintf("Table Name %q", id.TableName),
+		fmt.Sprintf("Table Service Name %q", id.TableServiceName),
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Table Entity Partition", segmentsStr)
+}
+
+func (id StorageTableEntityPartitionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/tableServices/%s/tables/%s/partitions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.TableServiceName, id.TableName, id.PartitionName)
+}
+
+// StorageTableEntityPartitionID parses a StorageTableEntityPartition ID into an StorageTableEntityPartitionId struct
+func StorageTableEntityPartitionID(input string) (*StorageTableEntityPartitionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an StorageTableEntityPartition ID: %+v", input, err)
+	}
+
+	resourceId := StorageTableEntityPartitionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.TableServiceName, err = id.PopSegment("tableServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.TableName, err = id.PopSegment("tables"); err != nil {
+		return nil, err
+	}
+	if resourceId.PartitionName, err = id.PopSegment("partitions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -91,7 +91,11 @@ func (r Registration) Resources() []sdk.Resource {
 		AccountTablePropertiesResource{},
 		AccountStaticWebsiteResource{},
 		LocalUserResource{},
+		StorageBlobDirectorySyncResource{},
+		StorageDataLakeGen2PathRecursiveAclResource{},
+		StorageShareDirectorySyncResource{},
 		StorageContainerImmutabilityPolicyResource{},
+		StorageTableEntitiesResource{},
 		SyncServerEndpointResource{},
 	}
 }
@@ -109,7 +113,11 @@ func (r Registration) FrameworkDataSources() []sdk.FrameworkWrappedDataSource {
 }
 
 func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewStorageAccountKeysEphemeralResource,
+		NewStorageAccountSASEphemeralResource,
+		NewStorageAccountUserDelegationSASEphemeralResource,
+	}
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
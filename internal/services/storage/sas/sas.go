@@ -0,0 +1,29 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sas
+
+import "strings"
+
+const (
+	// AccountSASSignedVersion is the default `signed_version` of the `azurerm_storage_account_sas` data source
+	AccountSASSignedVersion = "2022-11-02"
+
+	// the permissions, services and resource types of an Account SAS must be specified in this order
+	AccountSASPermissions   = "rwdxylacuptfi"
+	AccountSASServices      = "bqtf"
+	AccountSASResourceTypes = "sco"
+)
+
+// OrderCharacters returns the characters of input which are supported, in the order in which they must be specified
+// in the SAS
+func OrderCharacters(input, supported string) string {
+	result := ""
+	for _, c := range supported {
+		if strings.ContainsRune(input, c) {
+			result += string(c)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,402 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/file/directories"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/file/files"
+)
+
+type shareDirectorySyncFile struct {
+	// FileName is the path of the file relative to the root of the share
+	FileName   string
+	Path       string
+	Size       int64
+	ContentMD5 string
+}
+
+type shareDirectoryListResult struct {
+	NextMarker *string                   `xml:"NextMarker,omitempty"`
+	Entries    shareDirectoryListEntries `xml:"Entries"`
+}
+
+type shareDirectoryListEntries struct {
+	Files       []shareDirectoryListEntry `xml:"File"`
+	Directories []shareDirectoryListEntry `xml:"Directory"`
+}
+
+type shareDirectoryListEntry struct {
+	Name       string                            `xml:"Name"`
+	Properties shareDirectoryListEntryProperties `xml:"Properties"`
+}
+
+type shareDirectoryListEntryProperties struct {
+	ETag string `xml:"Etag"`
+}
+
+// ShareDirectorySync mirrors a local directory tree into a File Share. Listing the contents of a directory isn't
+// currently supported by Giovanni, so this is performed using the underlying client of the Directories Client.
+type ShareDirectorySync struct {
+	DirectoriesClient *directories.Client
+	FilesClient       *files.Client
+
+	ShareName   string
+	Parallelism int
+}
+
+// shareDirectorySyncLocalFiles walks sourceDirectory and returns the regular files within it keyed by their path
+// relative to the root of the share, along with the directories which need to exist for these - including targetPath
+// and each of its parents
+func shareDirectorySyncLocalFiles(sourceDirectory, targetPath string) (map[string]shareDirectorySyncFile, []string, error) {
+	localFiles := make(map[string]shareDirectorySyncFile)
+	directoryNames := shareDirectorySyncParents(targetPath)
+
+	err := filepath.WalkDir(sourceDirectory, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(sourceDirectory, filePath)
+		if err != nil {
+			return fmt.Errorf("determining relative path of %q: %+v", filePath, err)
+		}
+		if relativePath == "." {
+			return nil
+		}
+		name := path.Join(targetPath, filepath.ToSlash(relativePath))
+
+		if entry.IsDir() {
+			directoryNames = append(directoryNames, name)
+			return nil
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("retrieving file info for %q: %+v", filePath, err)
+		}
+
+		contentMD5, err := directorySyncFileMD5(filePath)
+		if err != nil {
+			return err
+		}
+
+		localFiles[name] = shareDirectorySyncFile{
+			FileName:   name,
+			Path:       filePath,
+			Size:       info.Size(),
+			ContentMD5: contentMD5,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("walking %q: %+v", sourceDirectory, err)
+	}
+
+	return localFiles, directoryNames, nil
+}
+
+// shareDirectorySyncParents returns the directory at directoryPath preceded by each of its parents
+func shareDirectorySyncParents(directoryPath string) []string {
+	result := make([]string, 0)
+	if directoryPath == "" {
+		return result
+	}
+
+	segments := strings.Split(directoryPath, "/")
+	for i := range segments {
+		result = append(result, strings.Join(segments[:i+1], "/"))
+	}
+	return result
+}
+
+func shareDirectorySyncContentMD5s(localFiles map[string]shareDirectorySyncFile) map[string]string {
+	result := make(map[string]string, len(localFiles))
+	for name, file := range localFiles {
+		result[name] = file.ContentMD5
+	}
+	return result
+}
+
+// shareDirectorySyncByDepth groups the directories by the number of path segments they contain, ordered from the
+// shallowest to the deepest, so that parent directories can be created before - and deleted after - their children
+func shareDirectorySyncByDepth(directoryNames []string) [][]string {
+	groups := make(map[int][]string)
+	depths := make([]int, 0)
+	for _, directory := range directoryNames {
+		depth := strings.Count(directory, "/")
+		if _, ok := groups[depth]; !ok {
+			depths = append(depths, depth)
+		}
+		groups[depth] = append(groups[depth], directory)
+	}
+	sort.Ints(depths)
+
+	result := make([][]string, 0, len(depths))
+	for _, depth := range depths {
+		group := groups[depth]
+		sort.Strings(group)
+		result = append(result, group)
+	}
+	return result
+}
+
+// List returns the ETag of each file keyed by file name, and the directories, beneath directoryPath - an empty
+// directoryPath refers to the root of the share. Each level of the tree is listed in parallel.
+func (s ShareDirectorySync) List(ctx context.Context, directoryPath string) (map[string]string, []string, error) {
+	files := make(map[string]string)
+	directoryNames := make([]string, 0)
+	mutex := &sync.Mutex{}
+
+	pending := []string{directoryPath}
+	for len(pending) > 0 {
+		current := pending
+		next := make([]string, 0)
+
+		err := runInParallel(s.Parallelism, len(current), func(i int) error {
+			fileEntries, directoryEntries, err := s.listDirectory(ctx, current[i])
+			if err != nil {
+				return fmt.Errorf("listing directory %q: %+v", current[i], err)
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			for _, entry := range fileEntries {
+				files[path.Join(current[i], entry.Name)] = entry.Properties.ETag
+			}
+			for _, entry := range directoryEntries {
+				name := path.Join(current[i], entry.Name)
+				directoryNames = append(directoryNames, name)
+				next = append(next, name)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pending = next
+	}
+
+	sort.Strings(directoryNames)
+
+	return files, directoryNames, nil
+}
+
+func (s ShareDirectorySync) listDirectory(ctx context.Context, directoryPath string) ([]shareDirectoryListEntry, []shareDirectoryListEntry, error) {
+	files := make([]shareDirectoryListEntry, 0)
+	directories := make([]shareDirectoryListEntry, 0)
+
+	requestPath := fmt.Sprintf("/%s", s.ShareName)
+	if directoryPath != "" {
+		requestPath = fmt.Sprintf("/%s/%s", s.ShareName, directoryPath)
+	}
+
+	marker := ""
+	for {
+		opts := client.RequestOptions{
+			ContentType: "application/xml; charset=utf-8",
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod: http.MethodGet,
+			OptionsObject: shareDirectoryListOptions{
+				marker: marker,
+			},
+			Path: requestPath,
+		}
+
+		req, err := s.DirectoriesClient.Client.NewRequest(ctx, opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("building request: %+v", err)
+		}
+
+		resp, err := req.Execute(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("executing request: %+v", err)
+		}
+
+		var result shareDirectoryListResult
+		if err := resp.Unmarshal(&result); err != nil {
+			return nil, nil, fmt.Errorf("unmarshalling response: %+v", err)
+		}
+
+		files = append(files, result.Entries.Files...)
+		directories = append(directories, result.Entries.Directories...)
+
+		marker = pointer.From(result.NextMarker)
+		if marker == "" {
+			break
+		}
+	}
+
+	return files, directories, nil
+}
+
+// ContentMD5s returns the base64 encoded content MD5 of each of the specified files, keyed by file name. The content
+// MD5 isn't included when listing a directory so the properties of up to Parallelism files are retrieved concurrently.
+func (s ShareDirectorySync) ContentMD5s(ctx context.Context, fileNames []string) (map[string]string, error) {
+	result := make(map[string]string, len(fileNames))
+	mutex := &sync.Mutex{}
+
+	err := runInParallel(s.Parallelism, len(fileNames), func(i int) error {
+		directoryPath, fileName := shareDirectorySyncSplit(fileNames[i])
+		props, err := s.FilesClient.GetProperties(ctx, s.ShareName, directoryPath, fileName)
+		if err != nil {
+			return fmt.Errorf("retrieving properties for file %q: %+v", fileNames[i], err)
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		result[fileNames[i]] = props.ContentMD5
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CreateDirectories creates the specified directories, parents are created before their children and directories
+// which already exist are ignored
+func (s ShareDirectorySync) CreateDirectories(ctx context.Context, directoryNames []string) error {
+	for _, group := range shareDirectorySyncByDepth(directoryNames) {
+		err := runInParallel(s.Parallelism, len(group), func(i int) error {
+			resp, err := s.DirectoriesClient.Create(ctx, s.ShareName, group[i], directories.CreateDirectoryInput{})
+			if err != nil && !response.WasConflict(resp.HttpResponse) {
+				return fmt.Errorf("creating directory %q: %+v", group[i], err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Upload uploads the specified files, up to Parallelism files are uploaded concurrently with the ranges of each file
+// also being uploaded in parallel
+func (s ShareDirectorySync) Upload(ctx context.Context, uploads []shareDirectorySyncFile) error {
+	return runInParallel(s.Parallelism, len(uploads), func(i int) error {
+		return s.uploadFile(ctx, uploads[i])
+	})
+}
+
+// DeleteFiles deletes the specified files, up to Parallelism files are deleted concurrently
+func (s ShareDirectorySync) DeleteFiles(ctx context.Context, fileNames []string) error {
+	return runInParallel(s.Parallelism, len(fileNames), func(i int) error {
+		directoryPath, fileName := shareDirectorySyncSplit(fileNames[i])
+		if resp, err := s.FilesClient.Delete(ctx, s.ShareName, directoryPath, fileName); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting file %q: %+v", fileNames[i], err)
+		}
+		return nil
+	})
+}
+
+// DeleteDirectories deletes the specified directories, which must be empty once their children have been deleted.
+// Children are deleted before their parents.
+func (s ShareDirectorySync) DeleteDirectories(ctx context.Context, directoryNames []string) error {
+	groups := shareDirectorySyncByDepth(directoryNames)
+	for i := len(groups) - 1; i >= 0; i-- {
+		group := groups[i]
+		err := runInParallel(s.Parallelism, len(group), func(j int) error {
+			if resp, err := s.DirectoriesClient.Delete(ctx, s.ShareName, group[j]); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("deleting directory %q: %+v", group[j], err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s ShareDirectorySync) uploadFile(ctx context.Context, source shareDirectorySyncFile) error {
+	file, err := os.Open(source.Path)
+	if err != nil {
+		return fmt.Errorf("opening %q: %+v", source.Path, err)
+	}
+	defer file.Close()
+
+	contentType, err := directorySyncContentType(file)
+	if err != nil {
+		return err
+	}
+
+	directoryPath, fileName := shareDirectorySyncSplit(source.FileName)
+
+	// creating the file replaces any existing file, allocating the full size which the ranges are then written into
+	input := files.CreateInput{
+		ContentLength: source.Size,
+		ContentMD5:    pointer.To(source.ContentMD5),
+		ContentType:   pointer.To(contentType),
+	}
+	if _, err := s.FilesClient.Create(ctx, s.ShareName, directoryPath, fileName, input); err != nil {
+		return fmt.Errorf("creating file %q: %+v", source.FileName, err)
+	}
+
+	if source.Size == 0 {
+		return nil
+	}
+
+	if err := s.FilesClient.PutFile(ctx, s.ShareName, directoryPath, fileName, file, s.Parallelism); err != nil {
+		return fmt.Errorf("uploading ranges for file %q: %+v", source.FileName, err)
+	}
+
+	return nil
+}
+
+// shareDirectorySyncSplit splits a file name relative to the root of the share into the directory and file name
+func shareDirectorySyncSplit(name string) (string, string) {
+	directoryPath, fileName := path.Split(name)
+	return strings.TrimSuffix(directoryPath, "/"), fileName
+}
+
+type shareDirectoryListOptions struct {
+	marker string
+}
+
+func (o shareDirectoryListOptions) ToHeaders() *client.Headers {
+	return nil
+}
+
+func (o shareDirectoryListOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o shareDirectoryListOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("restype", "directory")
+	out.Append("comp", "list")
+	// the ETag of each file is used to detect changes, since the content MD5 isn't included when listing a directory
+	out.Append("include", "Etag")
+	if o.marker != "" {
+		out.Append("marker", o.marker)
+	}
+	return out
+}
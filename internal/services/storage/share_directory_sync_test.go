@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestShareDirectorySyncLocalFiles(t *testing.T) {
+	sourceDirectory := t.TempDir()
+	for name, content := range map[string]string{
+		"index.html":        "<html></html>",
+		"css/site.css":      "body {}",
+		"data/empty.json":   "",
+		"data/nested/a.txt": "a",
+	} {
+		path := filepath.Join(sourceDirectory, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("creating directory for %q: %+v", name, err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(sourceDirectory, "empty"), 0o755); err != nil {
+		t.Fatalf("creating empty directory: %+v", err)
+	}
+
+	testcases := []struct {
+		TargetPath  string
+		Files       []string
+		Directories []string
+	}{
+		{
+			TargetPath:  "",
+			Files:       []string{"css/site.css", "data/empty.json", "data/nested/a.txt", "index.html"},
+			Directories: []string{"css", "data", "data/nested", "empty"},
+		},
+		{
+			TargetPath:  "site/v1",
+			Files:       []string{"site/v1/css/site.css", "site/v1/data/empty.json", "site/v1/data/nested/a.txt", "site/v1/index.html"},
+			Directories: []string{"site", "site/v1", "site/v1/css", "site/v1/data", "site/v1/data/nested", "site/v1/empty"},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Logf("[DEBUG] Testing %q", tc.TargetPath)
+
+		files, directories, err := shareDirectorySyncLocalFiles(sourceDirectory, tc.TargetPath)
+		if err != nil {
+			t.Fatalf("listing local files: %+v", err)
+		}
+
+		fileNames := make([]string, 0, len(files))
+		for name := range files {
+			fileNames = append(fileNames, name)
+		}
+		sort.Strings(fileNames)
+		sort.Strings(directories)
+
+		if !reflect.DeepEqual(fileNames, tc.Files) {
+			t.Fatalf("expected files %v but got %v", tc.Files, fileNames)
+		}
+		if !reflect.DeepEqual(directories, tc.Directories) {
+			t.Fatalf("expected directories %v but got %v", tc.Directories, directories)
+		}
+	}
+}
+
+func TestShareDirectorySyncByDepth(t *testing.T) {
+	actual := shareDirectorySyncByDepth([]string{"b/c", "a", "b", "a/d/e", "a/d"})
+	expected := [][]string{
+		{"a", "b"},
+		{"a/d", "b/c"},
+		{"a/d/e"},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v but got %v", expected, actual)
+	}
+}
+
+func TestShareDirectorySyncSplit(t *testing.T) {
+	testcases := []struct {
+		Input     string
+		Directory string
+		File      string
+	}{
+		{
+			Input:     "index.html",
+			Directory: "",
+			File:      "index.html",
+		},
+		{
+			Input:     "site/css/site.css",
+			Directory: "site/css",
+			File:      "site.css",
+		},
+	}
+
+	for _, tc := range testcases {
+		directory, file := shareDirectorySyncSplit(tc.Input)
+		if directory != tc.Directory || file != tc.File {
+			t.Fatalf("expected %q and %q for %q but got %q and %q", tc.Directory, tc.File, tc.Input, directory, file)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-08-01/storageaccounts"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+var _ sdk.EphemeralResource = &StorageAccountKeysEphemeralResource{}
+
+func NewStorageAccountKeysEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountKeysEphemeralResource{}
+}
+
+type StorageAccountKeysEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type StorageAccountKeysEphemeralResourceModel struct {
+	StorageAccountID          types.String `tfsdk:"storage_account_id"`
+	PrimaryAccessKey          types.String `tfsdk:"primary_access_key"`
+	SecondaryAccessKey        types.String `tfsdk:"secondary_access_key"`
+	PrimaryConnectionString   types.String `tfsdk:"primary_connection_string"`
+	SecondaryConnectionString types.String `tfsdk:"secondary_connection_string"`
+}
+
+func (e *StorageAccountKeysEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_storage_account_keys"
+}
+
+func (e *StorageAccountKeysEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *StorageAccountKeysEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"storage_account_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateStorageAccountID,
+					},
+				},
+			},
+
+			"primary_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_access_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"primary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"secondary_connection_string": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *StorageAccountKeysEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	client := e.Client.Storage.ResourceManager.StorageAccounts
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data StorageAccountKeysEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := commonids.ParseStorageAccountID(data.StorageAccountID.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	keys, err := client.ListKeys(ctx, *id, storageaccounts.DefaultListKeysOperationOptions())
+	if err != nil {
+		if response.WasNotFound(keys.HttpResponse) {
+			sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("%s was not found", id), err)
+			return
+		}
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing Keys for %s", id), err)
+		return
+	}
+
+	accessKeys := make([]string, 0)
+	if model := keys.Model; model != nil && model.Keys != nil {
+		for _, key := range *model.Keys {
+			accessKeys = append(accessKeys, pointer.From(key.Value))
+		}
+	}
+
+	if len(accessKeys) == 0 {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("listing Keys for %s", id), "no keys were returned")
+		return
+	}
+
+	domainSuffix := e.Client.Storage.StorageDomainSuffix
+
+	data.PrimaryAccessKey = types.StringValue(accessKeys[0])
+	data.PrimaryConnectionString = types.StringValue(fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", id.StorageAccountName, accessKeys[0], domainSuffix))
+	data.SecondaryAccessKey = types.StringValue("")
+	data.SecondaryConnectionString = types.StringValue("")
+	if len(accessKeys) > 1 {
+		data.SecondaryAccessKey = types.StringValue(accessKeys[1])
+		data.SecondaryConnectionString = types.StringValue(fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", id.StorageAccountName, accessKeys[1], domainSuffix))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/storage"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sas"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -22,7 +23,6 @@ const (
 // This is an ACCOUNT SAS : https://docs.microsoft.com/en-us/rest/api/storageservices/Constructing-an-Account-SAS
 // not Service SAS
 func dataSourceStorageAccountSharedAccessSignature() *pluginsdk.Resource {
-	const sasSignedVersion = "2022-11-02"
 	return &pluginsdk.Resource{
 		Read: dataSourceStorageAccountSasRead,
 
@@ -55,7 +55,7 @@ func dataSourceStorageAccountSharedAccessSignature() *pluginsdk.Resource {
 			"signed_version": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				Default:  sasSignedVersion,
+				Default:  sas.AccountSASSignedVersion,
 			},
 
 			"resource_types": {
@@ -246,87 +246,45 @@ func dataSourceStorageAccountSasRead(d *pluginsdk.ResourceData, _ interface{}) e
 }
 
 func BuildPermissionsString(perms map[string]interface{}) string {
-	retVal := ""
-
-	if val, pres := perms["read"].(bool); pres && val {
-		retVal += "r"
-	}
-
-	if val, pres := perms["write"].(bool); pres && val {
-		retVal += "w"
-	}
-
-	if val, pres := perms["delete"].(bool); pres && val {
-		retVal += "d"
-	}
-
-	if val, pres := perms["list"].(bool); pres && val {
-		retVal += "l"
-	}
-
-	if val, pres := perms["add"].(bool); pres && val {
-		retVal += "a"
-	}
-
-	if val, pres := perms["create"].(bool); pres && val {
-		retVal += "c"
-	}
-
-	if val, pres := perms["update"].(bool); pres && val {
-		retVal += "u"
-	}
-
-	if val, pres := perms["process"].(bool); pres && val {
-		retVal += "p"
-	}
-
-	if val, pres := perms["tag"].(bool); pres && val {
-		retVal += "t"
-	}
-
-	if val, pres := perms["filter"].(bool); pres && val {
-		retVal += "f"
-	}
-
-	return retVal
+	return buildSASCharacters(perms, map[string]string{
+		"read":    "r",
+		"write":   "w",
+		"delete":  "d",
+		"list":    "l",
+		"add":     "a",
+		"create":  "c",
+		"update":  "u",
+		"process": "p",
+		"tag":     "t",
+		"filter":  "f",
+	}, sas.AccountSASPermissions)
 }
 
 func BuildServicesString(services map[string]interface{}) string {
-	retVal := ""
-
-	if val, pres := services["blob"].(bool); pres && val {
-		retVal += "b"
-	}
-
-	if val, pres := services["queue"].(bool); pres && val {
-		retVal += "q"
-	}
-
-	if val, pres := services["table"].(bool); pres && val {
-		retVal += "t"
-	}
-
-	if val, pres := services["file"].(bool); pres && val {
-		retVal += "f"
-	}
-
-	return retVal
+	return buildSASCharacters(services, map[string]string{
+		"blob":  "b",
+		"queue": "q",
+		"table": "t",
+		"file":  "f",
+	}, sas.AccountSASServices)
 }
 
 func BuildResourceTypesString(resTypes map[string]interface{}) string {
-	retVal := ""
-
-	if val, pres := resTypes["service"].(bool); pres && val {
-		retVal += "s"
-	}
-
-	if val, pres := resTypes["container"].(bool); pres && val {
-		retVal += "c"
-	}
+	return buildSASCharacters(resTypes, map[string]string{
+		"service":   "s",
+		"container": "c",
+		"object":    "o",
+	}, sas.AccountSASResourceTypes)
+}
 
-	if val, pres := resTypes["object"].(bool); pres && val {
-		retVal += "o"
+// buildSASCharacters returns the characters of the enabled flags, in the order in which they must be specified in the SAS
+func buildSASCharacters(input map[string]interface{}, characters map[string]string, supported string) string {
+	result := ""
+	for key, character := range characters {
+		if val, pres := input[key].(bool); pres && val {
+			result += character
+		}
 	}
 
-	return retVal
+	return sas.OrderCharacters(result, supported)
 }
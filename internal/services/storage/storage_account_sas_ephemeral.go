@@ -0,0 +1,231 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/storage"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	// sasDefaultValidity is used when `validity` isn't specified
+	sasDefaultValidity = time.Hour
+
+	// sasClockSkew is subtracted from the start time, so that the SAS is valid immediately regardless of clock skew
+	sasClockSkew = 5 * time.Minute
+)
+
+var _ sdk.EphemeralResourceWithRenew = &StorageAccountSASEphemeralResource{}
+
+func NewStorageAccountSASEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountSASEphemeralResource{}
+}
+
+type StorageAccountSASEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type StorageAccountSASEphemeralResourceModel struct {
+	StorageAccountID types.String `tfsdk:"storage_account_id"`
+	Services         types.String `tfsdk:"services"`
+	ResourceTypes    types.String `tfsdk:"resource_types"`
+	Permissions      types.String `tfsdk:"permissions"`
+	Validity         types.String `tfsdk:"validity"`
+	HttpsOnly        types.Bool   `tfsdk:"https_only"`
+	IPAddresses      types.String `tfsdk:"ip_addresses"`
+	Start            types.String `tfsdk:"start"`
+	Expiry           types.String `tfsdk:"expiry"`
+	SAS              types.String `tfsdk:"sas"`
+}
+
+func (e *StorageAccountSASEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_storage_account_sas"
+}
+
+func (e *StorageAccountSASEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *StorageAccountSASEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"storage_account_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateStorageAccountID,
+					},
+				},
+			},
+
+			"services": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[bqtf]+$`), "must be a combination of `b`, `q`, `t` and `f`"),
+				},
+			},
+
+			"resource_types": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[sco]+$`), "must be a combination of `s`, `c` and `o`"),
+				},
+			},
+
+			"permissions": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[rwdxylacuptfi]+$`), "must be a combination of `r`, `w`, `d`, `x`, `y`, `l`, `a`, `c`, `u`, `p`, `t`, `f` and `i`"),
+				},
+			},
+
+			"validity": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					sasValidityValidator(),
+				},
+			},
+
+			"https_only": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+			},
+
+			"ip_addresses": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.Any(
+							validation.IsIPv4Address,
+							validation.IsIPv4Range,
+						),
+					},
+				},
+			},
+
+			"start": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"expiry": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"sas": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *StorageAccountSASEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data StorageAccountSASEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := commonids.ParseStorageAccountID(data.StorageAccountID.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	account, err := e.Client.Storage.GetAccount(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s", id), err)
+		return
+	}
+
+	accountKey, err := account.AccountKey(ctx, *e.Client.Storage)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving Account Key for %s", id), err)
+		return
+	}
+
+	start, expiry, validity := sasValidityPeriod(data.Validity.ValueString())
+
+	httpsOnly := true
+	if !data.HttpsOnly.IsNull() && !data.HttpsOnly.IsUnknown() {
+		httpsOnly = data.HttpsOnly.ValueBool()
+	}
+	signedProtocol := "https,http"
+	if httpsOnly {
+		signedProtocol = "https"
+	}
+
+	sasToken, err := storage.ComputeAccountSASToken(id.StorageAccountName, *accountKey,
+		sas.OrderCharacters(data.Permissions.ValueString(), sas.AccountSASPermissions),
+		sas.OrderCharacters(data.Services.ValueString(), sas.AccountSASServices),
+		sas.OrderCharacters(data.ResourceTypes.ValueString(), sas.AccountSASResourceTypes),
+		start.Format(time.RFC3339), expiry.Format(time.RFC3339), signedProtocol, data.IPAddresses.ValueString(), sas.AccountSASSignedVersion, "")
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("computing SAS for %s", id), err)
+		return
+	}
+
+	data.Validity = types.StringValue(validity.String())
+	data.HttpsOnly = types.BoolValue(httpsOnly)
+	data.Start = types.StringValue(start.Format(time.RFC3339))
+	data.Expiry = types.StringValue(expiry.Format(time.RFC3339))
+	data.SAS = types.StringValue(sasToken)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	sdk.SetEphemeralResourceExpiry(ctx, resp, expiry)
+}
+
+func (e *StorageAccountSASEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	sdk.RenewEphemeralResourceExpiry(ctx, req, resp, "SAS")
+}
+
+// sasValidityValidator validates that `validity` is a positive duration, e.g. `1h` or `30m`
+func sasValidityValidator() validator.String {
+	return typehelpers.WrappedStringValidator{
+		Func: func(i interface{}, k string) (warnings []string, errors []error) {
+			v, ok := i.(string)
+			if !ok {
+				errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+				return
+			}
+
+			d, err := time.ParseDuration(v)
+			if err != nil || d <= 0 {
+				errors = append(errors, fmt.Errorf("%q must be a positive duration such as `30m` or `1h`, got %q", k, v))
+			}
+
+			return
+		},
+	}
+}
+
+// sasValidityPeriod returns the start and expiry of a SAS issued now which is valid for the specified duration
+func sasValidityPeriod(input string) (start time.Time, expiry time.Time, validity time.Duration) {
+	validity = sasDefaultValidity
+	if input != "" {
+		// the value has already been validated by the schema
+		validity, _ = time.ParseDuration(input)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	return now.Add(-sasClockSkew), now.Add(validity), validity
+}
@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/sas"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	// the permissions of a Blob Service SAS must be specified in this order
+	userDelegationSASPermissions = "racwdxltmeopi"
+
+	// userDelegationSASMaximumValidity is the longest a User Delegation Key, and so the SAS, can be valid for
+	userDelegationSASMaximumValidity = 7 * 24 * time.Hour
+)
+
+var _ sdk.EphemeralResourceWithRenew = &StorageAccountUserDelegationSASEphemeralResource{}
+
+func NewStorageAccountUserDelegationSASEphemeralResource() ephemeral.EphemeralResource {
+	return &StorageAccountUserDelegationSASEphemeralResource{}
+}
+
+type StorageAccountUserDelegationSASEphemeralResource struct {
+	sdk.EphemeralResourceMetadata
+}
+
+type StorageAccountUserDelegationSASEphemeralResourceModel struct {
+	StorageAccountID types.String `tfsdk:"storage_account_id"`
+	ContainerName    types.String `tfsdk:"container_name"`
+	BlobName         types.String `tfsdk:"blob_name"`
+	Permissions      types.String `tfsdk:"permissions"`
+	Validity         types.String `tfsdk:"validity"`
+	Start            types.String `tfsdk:"start"`
+	Expiry           types.String `tfsdk:"expiry"`
+	SAS              types.String `tfsdk:"sas"`
+}
+
+func (e *StorageAccountUserDelegationSASEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "azurerm_storage_account_user_delegation_sas"
+}
+
+func (e *StorageAccountUserDelegationSASEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	e.Defaults(req, resp)
+}
+
+func (e *StorageAccountUserDelegationSASEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"storage_account_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateStorageAccountID,
+					},
+				},
+			},
+
+			"container_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.StorageContainerName,
+					},
+				},
+			},
+
+			"blob_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+
+			"permissions": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[racwdxltmeopi]+$`), "must be a combination of `r`, `a`, `c`, `w`, `d`, `x`, `l`, `t`, `m`, `e`, `o`, `p` and `i`"),
+				},
+			},
+
+			"validity": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					sasValidityValidator(),
+				},
+			},
+
+			"start": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"expiry": schema.StringAttribute{
+				Computed: true,
+			},
+
+			"sas": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *StorageAccountUserDelegationSASEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*5)
+	defer cancel()
+
+	var data StorageAccountUserDelegationSASEphemeralResourceModel
+
+	if ok := e.DecodeOpen(ctx, req, resp, &data); !ok {
+		return
+	}
+
+	id, err := commonids.ParseStorageAccountID(data.StorageAccountID.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, "", err)
+		return
+	}
+
+	start, expiry, validity := sasValidityPeriod(data.Validity.ValueString())
+	if validity > userDelegationSASMaximumValidity {
+		sdk.SetResponseErrorDiagnostic(resp, "invalid `validity`", fmt.Sprintf("a User Delegation SAS can be valid for at most %s, got %s", userDelegationSASMaximumValidity, validity))
+		return
+	}
+
+	account, err := e.Client.Storage.GetAccount(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving %s", id), err)
+		return
+	}
+
+	key, err := e.Client.Storage.GetUserDelegationKey(ctx, *account, start, expiry)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("retrieving User Delegation Key for %s", id), err)
+		return
+	}
+
+	sasToken, err := key.ComputeSASToken(id.StorageAccountName, data.ContainerName.ValueString(), data.BlobName.ValueString(),
+		sas.OrderCharacters(data.Permissions.ValueString(), userDelegationSASPermissions), start, expiry)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(resp, fmt.Sprintf("computing User Delegation SAS for %s", id), err)
+		return
+	}
+
+	data.Validity = types.StringValue(validity.String())
+	data.Start = types.StringValue(start.Format(time.RFC3339))
+	data.Expiry = types.StringValue(expiry.Format(time.RFC3339))
+	data.SAS = types.StringValue(sasToken)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+	sdk.SetEphemeralResourceExpiry(ctx, resp, expiry)
+}
+
+func (e *StorageAccountUserDelegationSASEphemeralResource) Renew(ctx context.Context, req ephemeral.RenewRequest, resp *ephemeral.RenewResponse) {
+	sdk.RenewEphemeralResourceExpiry(ctx, req, resp, "User Delegation SAS")
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type StorageAccountUserDelegationSASEphemeral struct{}
+
+func TestAccEphemeralStorageAccountUserDelegationSAS_container(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_storage_account_user_delegation_sas", "test")
+	r := StorageAccountUserDelegationSASEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.container(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("sas"), knownvalue.StringRegexp(regexp.MustCompile(`sp=rl&spr=https&sr=c&`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccEphemeralStorageAccountUserDelegationSAS_blob(t *testing.T) {
+	data := acceptance.BuildTestData(t, "ephemeral.azurerm_storage_account_user_delegation_sas", "test")
+	r := StorageAccountUserDelegationSASEphemeral{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0-rc1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ProtoV6ProviderFactories: framework.ProtoV6ProviderFactoriesInit(context.Background(), "azurerm", "echo"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.blob(data),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("validity"), knownvalue.StringExact("2h0m0s")),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("sas"), knownvalue.StringRegexp(regexp.MustCompile(`sp=rw&spr=https&sr=b&`))),
+				},
+			},
+		},
+	})
+}
+
+func (StorageAccountUserDelegationSASEphemeral) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name               = "content"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Delegator"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r StorageAccountUserDelegationSASEphemeral) container(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_storage_account_user_delegation_sas" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  container_name     = azurerm_storage_container.test.name
+  permissions        = "lr"
+
+  depends_on = [azurerm_role_assignment.test]
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_storage_account_user_delegation_sas.test
+}
+
+resource "echo" "test" {}
+`, r.template(data))
+}
+
+func (r StorageAccountUserDelegationSASEphemeral) blob(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+ephemeral "azurerm_storage_account_user_delegation_sas" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  container_name     = azurerm_storage_container.test.name
+  blob_name          = "example.txt"
+  permissions        = "wr"
+  validity           = "2h"
+
+  depends_on = [azurerm_role_assignment.test]
+}
+
+provider "echo" {
+  data = ephemeral.azurerm_storage_account_user_delegation_sas.test
+}
+
+resource "echo" "test" {}
+`, r.template(data))
+}
@@ -0,0 +1,472 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/shim"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/containers"
+)
+
+// storageBlobDirectorySyncDefaultName is used as the name segment of the ID when syncing to the root of the container
+const storageBlobDirectorySyncDefaultName = "default"
+
+type StorageBlobDirectorySyncResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageBlobDirectorySyncResource{}
+
+var _ sdk.ResourceWithCustomizeDiff = StorageBlobDirectorySyncResource{}
+
+type StorageBlobDirectorySyncModel struct {
+	StorageContainerId string `tfschema:"storage_container_id"`
+	SourceDirectory    string `tfschema:"source_directory"`
+	Prefix             string `tfschema:"prefix"`
+	CacheControl       string `tfschema:"cache_control"`
+	Parallelism        int64  `tfschema:"parallelism"`
+	ManifestHash       string `tfschema:"manifest_hash"`
+}
+
+func (r StorageBlobDirectorySyncResource) ResourceType() string {
+	return "azurerm_storage_blob_directory_sync"
+}
+
+func (r StorageBlobDirectorySyncResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageBlobDirectorySyncID
+}
+
+func (r StorageBlobDirectorySyncResource) ModelObject() interface{} {
+	return &StorageBlobDirectorySyncModel{}
+}
+
+func (r StorageBlobDirectorySyncResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_container_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageContainerID,
+		},
+
+		"source_directory": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"prefix": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[^/].*/$`),
+				"`prefix` must end with a `/` and must not start with a `/`",
+			),
+		},
+
+		"cache_control": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"parallelism": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      8,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"manifest_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			// the directory may be populated by another resource during the apply, in which case it can't be hashed yet
+			if !diff.NewValueKnown("source_directory") || !diff.NewValueKnown("prefix") {
+				return diff.SetNewComputed("manifest_hash")
+			}
+
+			sourceDirectory := diff.Get("source_directory").(string)
+			if _, err := os.Stat(sourceDirectory); err != nil {
+				if os.IsNotExist(err) {
+					return diff.SetNewComputed("manifest_hash")
+				}
+				return fmt.Errorf("checking `source_directory` %q: %+v", sourceDirectory, err)
+			}
+
+			files, err := blobDirectorySyncLocalFiles(sourceDirectory, diff.Get("prefix").(string))
+			if err != nil {
+				return err
+			}
+
+			manifestHash := directorySyncManifestHash(blobDirectorySyncContentMD5s(files))
+			if diff.Get("manifest_hash").(string) != manifestHash {
+				return diff.SetNew("manifest_hash", manifestHash)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var model StorageBlobDirectorySyncModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			containerId, err := commonids.ParseStorageContainerID(model.StorageContainerId)
+			if err != nil {
+				return err
+			}
+
+			name := storageBlobDirectorySyncDefaultName
+			if model.Prefix != "" {
+				name = url.PathEscape(model.Prefix)
+			}
+
+			id := parse.NewStorageBlobDirectorySyncID(containerId.SubscriptionId, containerId.ResourceGroupName, containerId.StorageAccountName, "default", containerId.ContainerName, name)
+
+			account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(containerId.SubscriptionId, containerId.ResourceGroupName, containerId.StorageAccountName))
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q for %s", containerId.StorageAccountName, id)
+			}
+
+			containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Containers Client for %s: %+v", id, err)
+			}
+
+			// all Blobs under the prefix are managed by this resource, so any existing Blobs would be deleted when these
+			// don't exist in the source directory, and when this resource is deleted
+			existing, err := r.listRemoteBlobs(ctx, containersClient, containerId.ContainerName, model.Prefix)
+			if err != nil {
+				return fmt.Errorf("checking for existing blobs for %s: %+v", id, err)
+			}
+
+			if !metadata.Client.Features.SkipImportCheckOnCreateAndAllowOverwritingExistingResources && len(existing) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			manifestHash, err := r.sync(ctx, metadata, *containerId, model, true)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			model.ManifestHash = manifestHash
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageBlobDirectorySyncID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state StorageBlobDirectorySyncModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			prefix, err := storageBlobDirectorySyncPrefix(*id)
+			if err != nil {
+				return err
+			}
+
+			containerId := commonids.NewStorageContainerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+			state.StorageContainerId = containerId.ID()
+			state.Prefix = prefix
+			if state.Parallelism == 0 {
+				state.Parallelism = 8
+			}
+
+			account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName))
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Containers Client for %s: %+v", id, err)
+			}
+
+			exists, err := containersClient.Exists(ctx, id.ContainerName)
+			if err != nil {
+				return fmt.Errorf("checking for existing Container for %s: %+v", id, err)
+			}
+			if !pointer.From(exists) {
+				return metadata.MarkAsGone(id)
+			}
+
+			remote, err := r.listRemoteBlobs(ctx, containersClient, id.ContainerName, prefix)
+			if err != nil {
+				return fmt.Errorf("listing blobs for %s: %+v", id, err)
+			}
+
+			state.ManifestHash = directorySyncManifestHash(remote)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageBlobDirectorySyncID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageBlobDirectorySyncModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			if !metadata.ResourceData.HasChanges("source_directory", "cache_control", "manifest_hash") {
+				return nil
+			}
+
+			containerId := commonids.NewStorageContainerID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ContainerName)
+
+			// the properties of unchanged blobs are only updated when they're re-uploaded
+			uploadAll := metadata.ResourceData.HasChange("cache_control")
+
+			manifestHash, err := r.sync(ctx, metadata, containerId, model, uploadAll)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			model.ManifestHash = manifestHash
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageBlobDirectorySyncID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageBlobDirectorySyncModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			prefix, err := storageBlobDirectorySyncPrefix(*id)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName))
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+			}
+			if account == nil {
+				return nil
+			}
+
+			containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Containers Client for %s: %+v", id, err)
+			}
+
+			exists, err := containersClient.Exists(ctx, id.ContainerName)
+			if err != nil {
+				return fmt.Errorf("checking for existing Container for %s: %+v", id, err)
+			}
+			if !pointer.From(exists) {
+				return nil
+			}
+
+			blobsClient, err := storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Blobs Client for %s: %+v", id, err)
+			}
+
+			remote, err := r.listRemoteBlobs(ctx, containersClient, id.ContainerName, prefix)
+			if err != nil {
+				return fmt.Errorf("listing blobs for %s: %+v", id, err)
+			}
+
+			blobNames := make([]string, 0, len(remote))
+			for name := range remote {
+				blobNames = append(blobNames, name)
+			}
+			sort.Strings(blobNames)
+
+			sync := BlobDirectorySync{
+				Client:        blobsClient,
+				ContainerName: id.ContainerName,
+				Parallelism:   int(model.Parallelism),
+			}
+			if err := sync.Delete(ctx, blobNames); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// sync uploads the files in the source directory which differ from the blobs under the prefix and removes any blobs
+// under the prefix which no longer have a corresponding file, returning the manifest hash of the synced content
+func (r StorageBlobDirectorySyncResource) sync(ctx context.Context, metadata sdk.ResourceMetaData, containerId commonids.StorageContainerId, model StorageBlobDirectorySyncModel, uploadAll bool) (string, error) {
+	storageClient := metadata.Client.Storage
+
+	local, err := blobDirectorySyncLocalFiles(model.SourceDirectory, model.Prefix)
+	if err != nil {
+		return "", err
+	}
+
+	account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(containerId.SubscriptionId, containerId.ResourceGroupName, containerId.StorageAccountName))
+	if err != nil {
+		return "", fmt.Errorf("retrieving Storage Account: %+v", err)
+	}
+	if account == nil {
+		return "", fmt.Errorf("locating Storage Account %q", containerId.StorageAccountName)
+	}
+
+	containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return "", fmt.Errorf("building Containers Client: %+v", err)
+	}
+
+	blobsClient, err := storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return "", fmt.Errorf("building Blobs Client: %+v", err)
+	}
+
+	remote, err := r.listRemoteBlobs(ctx, containersClient, containerId.ContainerName, model.Prefix)
+	if err != nil {
+		return "", fmt.Errorf("listing blobs: %+v", err)
+	}
+
+	uploads := make([]blobDirectorySyncFile, 0)
+	for name, file := range local {
+		if contentMD5, ok := remote[name]; uploadAll || !ok || contentMD5 != file.ContentMD5 {
+			uploads = append(uploads, file)
+		}
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		return uploads[i].BlobName < uploads[j].BlobName
+	})
+
+	deletions := make([]string, 0)
+	for name := range remote {
+		if _, ok := local[name]; !ok {
+			deletions = append(deletions, name)
+		}
+	}
+	sort.Strings(deletions)
+
+	sync := BlobDirectorySync{
+		Client:        blobsClient,
+		ContainerName: containerId.ContainerName,
+		CacheControl:  model.CacheControl,
+		Parallelism:   int(model.Parallelism),
+	}
+
+	if err := sync.Upload(ctx, uploads); err != nil {
+		return "", fmt.Errorf("uploading %d changed files: %+v", len(uploads), err)
+	}
+
+	if err := sync.Delete(ctx, deletions); err != nil {
+		return "", fmt.Errorf("deleting %d removed blobs: %+v", len(deletions), err)
+	}
+
+	return directorySyncManifestHash(blobDirectorySyncContentMD5s(local)), nil
+}
+
+// listRemoteBlobs returns the base64 encoded content MD5 of each blob under the prefix, keyed by blob name
+func (r StorageBlobDirectorySyncResource) listRemoteBlobs(ctx context.Context, client shim.StorageContainerWrapper, containerName, prefix string) (map[string]string, error) {
+	input := containers.ListBlobsInput{}
+	if prefix != "" {
+		input.Prefix = pointer.To(prefix)
+	}
+
+	blobs, err := client.ListBlobs(ctx, containerName, input)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(blobs))
+	for _, blob := range blobs {
+		contentMD5 := ""
+		if blob.Properties != nil {
+			contentMD5 = pointer.From(blob.Properties.ContentMD5)
+		}
+		result[blob.Name] = contentMD5
+	}
+
+	return result, nil
+}
+
+func storageBlobDirectorySyncPrefix(id parse.StorageBlobDirectorySyncId) (string, error) {
+	if id.DirectorySyncName == storageBlobDirectorySyncDefaultName {
+		return "", nil
+	}
+
+	prefix, err := url.PathUnescape(id.DirectorySyncName)
+	if err != nil {
+		return "", fmt.Errorf("decoding prefix from %s: %+v", id, err)
+	}
+
+	return prefix, nil
+}
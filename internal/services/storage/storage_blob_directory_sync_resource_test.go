@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/containers"
+)
+
+type StorageBlobDirectorySyncResource struct{}
+
+func TestAccStorageBlobDirectorySync_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory_sync", "test")
+	r := StorageBlobDirectorySyncResource{}
+
+	sourceDirectory := r.sourceDirectory(t, map[string]string{
+		"index.html":      "<html><body>hello</body></html>",
+		"css/site.css":    "body { color: black; }",
+		"data/empty.json": "",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("manifest_hash").Exists(),
+			),
+		},
+		data.ImportStep("source_directory"),
+	})
+}
+
+func TestAccStorageBlobDirectorySync_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory_sync", "test")
+	r := StorageBlobDirectorySyncResource{}
+
+	sourceDirectory := r.sourceDirectory(t, map[string]string{
+		"index.html": "<html><body>hello</body></html>",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, sourceDirectory)
+		}),
+	})
+}
+
+func TestAccStorageBlobDirectorySync_prefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory_sync", "test")
+	r := StorageBlobDirectorySyncResource{}
+
+	sourceDirectory := r.sourceDirectory(t, map[string]string{
+		"index.html":   "<html><body>hello</body></html>",
+		"css/site.css": "body { color: black; }",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, sourceDirectory, "public, max-age=300"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("prefix").HasValue("site/"),
+			),
+		},
+		data.ImportStep("source_directory", "cache_control", "parallelism"),
+	})
+}
+
+func TestAccStorageBlobDirectorySync_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_directory_sync", "test")
+	r := StorageBlobDirectorySyncResource{}
+
+	sourceDirectory := r.sourceDirectory(t, map[string]string{
+		"index.html":   "<html><body>hello</body></html>",
+		"css/site.css": "body { color: black; }",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, sourceDirectory, "public, max-age=300"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source_directory", "cache_control", "parallelism"),
+		{
+			PreConfig: func() {
+				r.writeFiles(t, sourceDirectory, map[string]string{
+					"index.html":  "<html><body>hello again</body></html>",
+					"js/site.js":  "console.log('hello');",
+					"robots.txt":  "User-agent: *",
+					"favicon.ico": "\x00\x00\x01\x00",
+				})
+				if err := os.Remove(filepath.Join(sourceDirectory, "css", "site.css")); err != nil {
+					t.Fatalf("removing file: %+v", err)
+				}
+			},
+			Config: r.complete(data, sourceDirectory, "public, max-age=300"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source_directory", "cache_control", "parallelism"),
+		{
+			Config: r.complete(data, sourceDirectory, "no-cache"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source_directory", "cache_control", "parallelism"),
+	})
+}
+
+func (r StorageBlobDirectorySyncResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageBlobDirectorySyncID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.GetAccount(ctx, commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Storage Account for %s", id)
+	}
+
+	containersClient, err := client.Storage.ContainersDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Containers Client: %+v", err)
+	}
+
+	input := containers.ListBlobsInput{}
+	if prefix := state.Attributes["prefix"]; prefix != "" {
+		input.Prefix = pointer.To(prefix)
+	}
+
+	blobs, err := containersClient.ListBlobs(ctx, id.ContainerName, input)
+	if err != nil {
+		return nil, fmt.Errorf("listing blobs for %s: %+v", id, err)
+	}
+
+	return pointer.To(len(blobs) > 0), nil
+}
+
+func (r StorageBlobDirectorySyncResource) sourceDirectory(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+	r.writeFiles(t, directory, files)
+	return directory
+}
+
+func (r StorageBlobDirectorySyncResource) writeFiles(t *testing.T, directory string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(directory, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("creating directory for %q: %+v", name, err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+}
+
+func (r StorageBlobDirectorySyncResource) basic(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory_sync" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  source_directory     = %q
+}
+`, r.template(data), sourceDirectory)
+}
+
+func (r StorageBlobDirectorySyncResource) requiresImport(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory_sync" "import" {
+  storage_container_id = azurerm_storage_blob_directory_sync.test.storage_container_id
+  source_directory     = azurerm_storage_blob_directory_sync.test.source_directory
+}
+`, r.basic(data, sourceDirectory))
+}
+
+func (r StorageBlobDirectorySyncResource) complete(data acceptance.TestData, sourceDirectory, cacheControl string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_directory_sync" "test" {
+  storage_container_id = azurerm_storage_container.test.id
+  source_directory     = %q
+  prefix               = "site/"
+  cache_control        = %q
+  parallelism          = 4
+}
+`, r.template(data), sourceDirectory, cacheControl)
+}
+
+func (r StorageBlobDirectorySyncResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "sync"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -5,8 +5,12 @@ package storage
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
+	"io"
 	"log"
+	"os"
 	"strings"
 	"time"
 
@@ -137,10 +141,16 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			"content_md5": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
+				Computed:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"source_uri"},
 			},
 
+			"etag": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"url": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -164,7 +174,8 @@ func resourceStorageBlob() *pluginsdk.Resource {
 					return fmt.Errorf(`"source" must be aligned to 512-byte boundary for "type" set to "Page"`)
 				}
 			}
-			return nil
+
+			return storageBlobContentMD5CustomizeDiff(diff)
 		},
 	}
 
@@ -275,6 +286,10 @@ func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	contentMD5Raw := d.Get("content_md5").(string)
+	if strings.EqualFold(d.Get("type").(string), "Page") && d.GetRawConfig().AsValueMap()["content_md5"].IsNull() {
+		// the computed `content_md5` of a Page blob can't be specified on upload, it's set once the pages have been written
+		contentMD5Raw = ""
+	}
 	contentMD5 := ""
 	if contentMD5Raw != "" {
 		// Azure uses a Base64 encoded representation of the standard MD5 sum of the file
@@ -539,6 +554,8 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 	}
 	d.Set("content_md5", contentMD5)
 
+	d.Set("etag", props.ETag)
+
 	d.Set("encryption_scope", props.EncryptionScope)
 
 	d.Set("type", strings.TrimSuffix(string(props.BlobType), "Blob"))
@@ -556,6 +573,62 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 	return nil
 }
 
+// storageBlobContentMD5CustomizeDiff computes `content_md5` from the local `source` or `source_content` when it isn't
+// specified, so that the blob is replaced when either the local content or the content of the blob changes
+func storageBlobContentMD5CustomizeDiff(diff *pluginsdk.ResourceDiff) error {
+	// given `content_md5` is Computed, `GetRawConfig` is required to determine whether it's set in the config
+	if v := diff.GetRawConfig().AsValueMap()["content_md5"]; !v.IsNull() {
+		return nil
+	}
+
+	blobType := diff.Get("type").(string)
+	if blobType != "Block" && blobType != "Page" {
+		return nil
+	}
+
+	if !diff.NewValueKnown("source") || !diff.NewValueKnown("source_content") {
+		if diff.Id() == "" {
+			return diff.SetNewComputed("content_md5")
+		}
+		return nil
+	}
+
+	hash := md5.New()
+	if source := diff.Get("source").(string); source != "" {
+		file, err := os.Open(source)
+		if err != nil {
+			if os.IsNotExist(err) && diff.Id() == "" {
+				// the file may be created by another resource during the apply
+				return diff.SetNewComputed("content_md5")
+			}
+			return fmt.Errorf("opening `source` %q: %+v", source, err)
+		}
+		defer file.Close()
+
+		if _, err := io.Copy(hash, file); err != nil {
+			return fmt.Errorf("computing MD5 of `source` %q: %+v", source, err)
+		}
+	} else if content := diff.Get("source_content").(string); content != "" {
+		hash.Write([]byte(content))
+	} else {
+		return nil
+	}
+
+	contentMD5 := hex.EncodeToString(hash.Sum(nil))
+	existing := diff.Get("content_md5").(string)
+
+	// Page blobs created prior to `content_md5` being computed don't have an MD5 set
+	if blobType == "Page" && diff.Id() != "" && existing == "" {
+		return nil
+	}
+
+	if existing != contentMD5 {
+		return diff.SetNew("content_md5", contentMD5)
+	}
+
+	return nil
+}
+
 func resourceStorageBlobDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -0,0 +1,676 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/datalakestore/paths"
+	"github.com/jackofallops/giovanni/storage/accesscontrol"
+)
+
+// storageDataLakeGen2PathRecursiveAclRootPath is the `path` used to apply the ACL from the root of the filesystem
+const storageDataLakeGen2PathRecursiveAclRootPath = "/"
+
+// storageDataLakeGen2PathRecursiveAclReadParallelism is the number of paths whose ACL is retrieved concurrently when checking for divergence
+const storageDataLakeGen2PathRecursiveAclReadParallelism = 16
+
+// storageDataLakeGen2PathRecursiveAclMaxReportedFailures limits the number of failed paths included in an error
+const storageDataLakeGen2PathRecursiveAclMaxReportedFailures = 20
+
+// storageDataLakeGen2PathRecursiveAclMaxDivergentPaths limits the number of divergent paths stored in the state, since any
+// divergent path results in the ACL being re-applied to the whole tree
+const storageDataLakeGen2PathRecursiveAclMaxDivergentPaths = 100
+
+// storageDataLakeGen2PathRecursiveAclDefaultDivergenceCheckPathLimit is the default number of paths beneath `path` whose
+// ACL is checked when reading
+const storageDataLakeGen2PathRecursiveAclDefaultDivergenceCheckPathLimit = 1000
+
+type StorageDataLakeGen2PathRecursiveAclResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageDataLakeGen2PathRecursiveAclResource{}
+
+var _ sdk.ResourceWithCustomizeDiff = StorageDataLakeGen2PathRecursiveAclResource{}
+
+type StorageDataLakeGen2PathRecursiveAclModel struct {
+	StorageAccountId         string                                     `tfschema:"storage_account_id"`
+	FileSystemName           string                                     `tfschema:"filesystem_name"`
+	Path                     string                                     `tfschema:"path"`
+	Ace                      []StorageDataLakeGen2PathRecursiveAceModel `tfschema:"ace"`
+	Mode                     string                                     `tfschema:"mode"`
+	BatchSize                int64                                      `tfschema:"batch_size"`
+	ContinueOnFailure        bool                                       `tfschema:"continue_on_failure"`
+	DivergenceCheckPathLimit int64                                      `tfschema:"divergence_check_path_limit"`
+	DivergentPaths           []string                                   `tfschema:"divergent_paths"`
+}
+
+type StorageDataLakeGen2PathRecursiveAceModel struct {
+	Scope       string `tfschema:"scope"`
+	Type        string `tfschema:"type"`
+	Id          string `tfschema:"id"`
+	Permissions string `tfschema:"permissions"`
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) ResourceType() string {
+	return "azurerm_storage_data_lake_gen2_path_recursive_acl"
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageDataLakeGen2PathRecursiveAclID
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) ModelObject() interface{} {
+	return &StorageDataLakeGen2PathRecursiveAclModel{}
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageAccountID,
+		},
+
+		"filesystem_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validateStorageDataLakeGen2FileSystemName,
+		},
+
+		"path": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^(/|[^/].*[^/]|[^/])$`),
+				"`path` must be `/` for the root of the filesystem, otherwise it must not start or end with a `/`",
+			),
+		},
+
+		"ace": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"scope": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"default", "access"}, false),
+						Default:      "access",
+					},
+					"type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"user", "group", "mask", "other"}, false),
+					},
+					"id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsUUID,
+					},
+					"permissions": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.ADLSAccessControlPermissions,
+					},
+				},
+			},
+		},
+
+		"mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  string(DataLakeGen2RecursiveAclModeModify),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(DataLakeGen2RecursiveAclModeModify),
+				string(DataLakeGen2RecursiveAclModeSet),
+			}, false),
+		},
+
+		"batch_size": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      2000,
+			ValidateFunc: validation.IntBetween(1, 2000),
+		},
+
+		"continue_on_failure": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"divergence_check_path_limit": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      storageDataLakeGen2PathRecursiveAclDefaultDivergenceCheckPathLimit,
+			ValidateFunc: validation.IntBetween(1, 10000),
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"divergent_paths": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			// paths whose ACL was found to diverge during the refresh are reconciled by re-applying the ACL
+			if diff.Id() != "" && len(diff.Get("divergent_paths").([]interface{})) > 0 {
+				return diff.SetNew("divergent_paths", []interface{}{})
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var model StorageDataLakeGen2PathRecursiveAclModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			accountId, err := commonids.ParseStorageAccountID(model.StorageAccountId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStorageDataLakeGen2PathRecursiveAclID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, "default", model.FileSystemName, url.PathEscape(model.Path))
+
+			acl, err := expandStorageDataLakeGen2PathRecursiveAces(model.Ace)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.GetAccount(ctx, *accountId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating %s", accountId)
+			}
+
+			pathsClient, err := storageClient.DataLakePathsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Data Lake Gen2 Paths Client: %+v", err)
+			}
+
+			path := storageDataLakeGen2PathRecursiveAclDataPlanePath(model.Path)
+			resp, err := pathsClient.GetProperties(ctx, model.FileSystemName, path, paths.GetPropertiesInput{Action: paths.GetPropertiesActionGetAccessControl})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("the Path %q was not found in the File System %q", model.Path, model.FileSystemName)
+				}
+				return fmt.Errorf("retrieving ACL of Path %q in File System %q: %+v", model.Path, model.FileSystemName, err)
+			}
+
+			// the ACL isn't otherwise tied to this resource, so named entries which aren't declared indicate that the ACL
+			// of the path is already managed - either by another resource or outside of Terraform
+			if !metadata.Client.Features.SkipImportCheckOnCreateAndAllowOverwritingExistingResources {
+				existing, err := accesscontrol.ParseACL(resp.ACL)
+				if err != nil {
+					return fmt.Errorf("parsing ACL %q of Path %q: %+v", resp.ACL, model.Path, err)
+				}
+				if dataLakeGen2AclHasUndeclaredEntries(*acl, existing) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			recursiveAcl := DataLakeGen2RecursiveAcl{
+				Client:            pathsClient,
+				FileSystemName:    model.FileSystemName,
+				Path:              path,
+				BatchSize:         int(model.BatchSize),
+				ContinueOnFailure: model.ContinueOnFailure,
+			}
+
+			failures, err := recursiveAcl.Apply(ctx, DataLakeGen2RecursiveAclMode(model.Mode), acl.String())
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+			if len(failures) > 0 {
+				return fmt.Errorf("creating %s: %s", id, storageDataLakeGen2PathRecursiveAclFailures(failures))
+			}
+
+			metadata.SetID(id)
+
+			model.DivergentPaths = make([]string, 0)
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		// allows for the ACL of up to 10000 paths to be checked
+		Timeout: 15 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageDataLakeGen2PathRecursiveAclID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state StorageDataLakeGen2PathRecursiveAclModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			path, err := storageDataLakeGen2PathRecursiveAclPath(*id)
+			if err != nil {
+				return err
+			}
+
+			accountId := commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName)
+			state.StorageAccountId = accountId.ID()
+			state.FileSystemName = id.ContainerName
+			state.Path = path
+			path = storageDataLakeGen2PathRecursiveAclDataPlanePath(path)
+			if state.Mode == "" {
+				state.Mode = string(DataLakeGen2RecursiveAclModeModify)
+			}
+			if state.BatchSize == 0 {
+				state.BatchSize = 2000
+			}
+			if state.DivergenceCheckPathLimit == 0 {
+				state.DivergenceCheckPathLimit = storageDataLakeGen2PathRecursiveAclDefaultDivergenceCheckPathLimit
+			}
+
+			account, err := storageClient.GetAccount(ctx, accountId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			filesystemsClient, err := storageClient.DataLakeFilesystemsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Data Lake Gen2 Filesystems Client: %+v", err)
+			}
+
+			if resp, err := filesystemsClient.GetProperties(ctx, id.ContainerName); err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving File System %q for %s: %+v", id.ContainerName, id, err)
+			}
+
+			pathsClient, err := storageClient.DataLakePathsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Data Lake Gen2 Paths Client: %+v", err)
+			}
+
+			// the ACL of the root of the tree is retrieved separately, since it isn't included when listing the paths beneath it
+			root, err := pathsClient.GetProperties(ctx, id.ContainerName, path, paths.GetPropertiesInput{Action: paths.GetPropertiesActionGetAccessControl})
+			if err != nil {
+				if response.WasNotFound(root.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving ACL of Path %q for %s: %+v", path, id, err)
+			}
+
+			// the ACEs can't be retrieved from the API since each path may have a different ACL, so only the paths which
+			// diverge from the ACEs in the state are determined
+			declared, err := expandStorageDataLakeGen2PathRecursiveAces(state.Ace)
+			if err != nil {
+				return err
+			}
+
+			recursiveAcl := DataLakeGen2RecursiveAcl{
+				Client:         pathsClient,
+				FileSystemName: id.ContainerName,
+				Path:           path,
+			}
+
+			// checking every path would require a request per path on each refresh, so only the first paths beneath the
+			// root are checked
+			entries, err := recursiveAcl.ListPaths(ctx, int(state.DivergenceCheckPathLimit))
+			if err != nil {
+				return fmt.Errorf("listing paths for %s: %+v", id, err)
+			}
+
+			divergentPaths, err := r.divergentPaths(ctx, pathsClient, id.ContainerName, entries, *declared, DataLakeGen2RecursiveAclMode(state.Mode))
+			if err != nil {
+				return fmt.Errorf("checking ACLs for %s: %+v", id, err)
+			}
+
+			rootAcl, err := accesscontrol.ParseACL(root.ACL)
+			if err != nil {
+				return fmt.Errorf("parsing ACL %q of Path %q: %+v", root.ACL, path, err)
+			}
+			if dataLakeGen2AclDiverges(*declared, rootAcl, true, DataLakeGen2RecursiveAclMode(state.Mode)) {
+				divergentPaths = append([]string{state.Path}, divergentPaths...)
+			}
+			if len(divergentPaths) > storageDataLakeGen2PathRecursiveAclMaxDivergentPaths {
+				divergentPaths = divergentPaths[:storageDataLakeGen2PathRecursiveAclMaxDivergentPaths]
+			}
+
+			state.DivergentPaths = divergentPaths
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageDataLakeGen2PathRecursiveAclID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageDataLakeGen2PathRecursiveAclModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			if !metadata.ResourceData.HasChanges("ace", "mode", "divergent_paths") {
+				return nil
+			}
+
+			path, err := storageDataLakeGen2PathRecursiveAclPath(*id)
+			if err != nil {
+				return err
+			}
+			path = storageDataLakeGen2PathRecursiveAclDataPlanePath(path)
+
+			acl, err := expandStorageDataLakeGen2PathRecursiveAces(model.Ace)
+			if err != nil {
+				return err
+			}
+
+			accountId := commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName)
+			account, err := storageClient.GetAccount(ctx, accountId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating %s", accountId)
+			}
+
+			pathsClient, err := storageClient.DataLakePathsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Data Lake Gen2 Paths Client: %+v", err)
+			}
+
+			recursiveAcl := DataLakeGen2RecursiveAcl{
+				Client:            pathsClient,
+				FileSystemName:    id.ContainerName,
+				Path:              path,
+				BatchSize:         int(model.BatchSize),
+				ContinueOnFailure: model.ContinueOnFailure,
+			}
+
+			// named entries which have been removed from the configuration would otherwise be left behind when modifying
+			if metadata.ResourceData.HasChange("ace") && model.Mode == string(DataLakeGen2RecursiveAclModeModify) {
+				oldRaw, _ := metadata.ResourceData.GetChange("ace")
+				previous, err := ExpandDataLakeGen2AceList(oldRaw.(*pluginsdk.Set).List())
+				if err != nil {
+					return fmt.Errorf("parsing previous ace list: %+v", err)
+				}
+
+				if removed := storageDataLakeGen2PathRecursiveAclRemovedEntries(previous, acl); removed != "" {
+					failures, err := recursiveAcl.Apply(ctx, DataLakeGen2RecursiveAclModeRemove, removed)
+					if err != nil {
+						return fmt.Errorf("removing entries for %s: %+v", id, err)
+					}
+					if len(failures) > 0 {
+						return fmt.Errorf("removing entries for %s: %s", id, storageDataLakeGen2PathRecursiveAclFailures(failures))
+					}
+				}
+			}
+
+			failures, err := recursiveAcl.Apply(ctx, DataLakeGen2RecursiveAclMode(model.Mode), acl.String())
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+			if len(failures) > 0 {
+				return fmt.Errorf("updating %s: %s", id, storageDataLakeGen2PathRecursiveAclFailures(failures))
+			}
+
+			model.DivergentPaths = make([]string, 0)
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageDataLakeGen2PathRecursiveAclID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageDataLakeGen2PathRecursiveAclModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			path, err := storageDataLakeGen2PathRecursiveAclPath(*id)
+			if err != nil {
+				return err
+			}
+			path = storageDataLakeGen2PathRecursiveAclDataPlanePath(path)
+
+			acl, err := expandStorageDataLakeGen2PathRecursiveAces(model.Ace)
+			if err != nil {
+				return err
+			}
+
+			// only the named entries can be removed, the entries for the owning user and group, the mask and other
+			// are required on every path so are left as they are
+			removed := dataLakeGen2AclRemovalString(*acl)
+			if removed == "" {
+				return nil
+			}
+
+			accountId := commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName)
+			account, err := storageClient.GetAccount(ctx, accountId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+			if account == nil {
+				return nil
+			}
+
+			pathsClient, err := storageClient.DataLakePathsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Data Lake Gen2 Paths Client: %+v", err)
+			}
+
+			resp, err := pathsClient.GetProperties(ctx, id.ContainerName, path, paths.GetPropertiesInput{Action: paths.GetPropertiesActionGetStatus})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving Path %q for %s: %+v", path, id, err)
+			}
+
+			recursiveAcl := DataLakeGen2RecursiveAcl{
+				Client:            pathsClient,
+				FileSystemName:    id.ContainerName,
+				Path:              path,
+				BatchSize:         int(model.BatchSize),
+				ContinueOnFailure: model.ContinueOnFailure,
+			}
+
+			failures, err := recursiveAcl.Apply(ctx, DataLakeGen2RecursiveAclModeRemove, removed)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+			if len(failures) > 0 {
+				return fmt.Errorf("deleting %s: %s", id, storageDataLakeGen2PathRecursiveAclFailures(failures))
+			}
+
+			return nil
+		},
+	}
+}
+
+// divergentPaths retrieves the ACL of each path concurrently, returning the sorted names of those which diverge from the declared ACL
+func (r StorageDataLakeGen2PathRecursiveAclResource) divergentPaths(ctx context.Context, client *paths.Client, fileSystemName string, entries []dataLakeGen2PathEntry, declared accesscontrol.ACL, mode DataLakeGen2RecursiveAclMode) ([]string, error) {
+	result := make([]string, 0)
+	lock := &sync.Mutex{}
+
+	err := runInParallel(storageDataLakeGen2PathRecursiveAclReadParallelism, len(entries), func(i int) error {
+		entry := entries[i]
+
+		resp, err := client.GetProperties(ctx, fileSystemName, entry.Name, paths.GetPropertiesInput{Action: paths.GetPropertiesActionGetAccessControl})
+		if err != nil {
+			// the path may have been removed since it was listed
+			if response.WasNotFound(resp.HttpResponse) {
+				return nil
+			}
+			return fmt.Errorf("retrieving ACL of Path %q: %+v", entry.Name, err)
+		}
+
+		actual, err := accesscontrol.ParseACL(resp.ACL)
+		if err != nil {
+			return fmt.Errorf("parsing ACL %q of Path %q: %+v", resp.ACL, entry.Name, err)
+		}
+
+		if dataLakeGen2AclDiverges(declared, actual, strings.EqualFold(entry.IsDirectory, "true"), mode) {
+			lock.Lock()
+			result = append(result, entry.Name)
+			lock.Unlock()
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(result)
+
+	return result, nil
+}
+
+func expandStorageDataLakeGen2PathRecursiveAces(input []StorageDataLakeGen2PathRecursiveAceModel) (*accesscontrol.ACL, error) {
+	aces := make([]interface{}, 0, len(input))
+	for _, ace := range input {
+		aces = append(aces, map[string]interface{}{
+			"scope":       ace.Scope,
+			"type":        ace.Type,
+			"id":          ace.Id,
+			"permissions": ace.Permissions,
+		})
+	}
+
+	acl, err := ExpandDataLakeGen2AceList(aces)
+	if err != nil {
+		return nil, fmt.Errorf("parsing ace list: %+v", err)
+	}
+	if acl == nil {
+		acl = &accesscontrol.ACL{}
+	}
+
+	return acl, nil
+}
+
+// storageDataLakeGen2PathRecursiveAclRemovedEntries returns the named entries of the previous ACL which are no longer
+// present in the current ACL, in the format expected when removing entries
+func storageDataLakeGen2PathRecursiveAclRemovedEntries(previous, current *accesscontrol.ACL) string {
+	if previous == nil {
+		return ""
+	}
+
+	removed := accesscontrol.ACL{}
+	for _, old := range previous.Entries {
+		if old.TagQualifier == nil {
+			continue
+		}
+
+		found := false
+		for _, ace := range current.Entries {
+			if ace.TagType == old.TagType && ace.IsDefault == old.IsDefault && ace.TagQualifier != nil && *ace.TagQualifier == *old.TagQualifier {
+				found = true
+				break
+			}
+		}
+		if !found {
+			removed.Entries = append(removed.Entries, old)
+		}
+	}
+
+	return dataLakeGen2AclRemovalString(removed)
+}
+
+func storageDataLakeGen2PathRecursiveAclFailures(failures []dataLakeGen2RecursiveAclFailure) string {
+	messages := make([]string, 0)
+	for i, failure := range failures {
+		if i == storageDataLakeGen2PathRecursiveAclMaxReportedFailures {
+			messages = append(messages, fmt.Sprintf("and %d more", len(failures)-i))
+			break
+		}
+		messages = append(messages, fmt.Sprintf("%s %q: %s", failure.Type, failure.Name, failure.ErrorMessage))
+	}
+
+	return fmt.Sprintf("the ACL could not be applied to %d paths: %s", len(failures), strings.Join(messages, "; "))
+}
+
+func storageDataLakeGen2PathRecursiveAclPath(id parse.StorageDataLakeGen2PathRecursiveAclId) (string, error) {
+	path, err := url.PathUnescape(id.RecursiveAclName)
+	if err != nil {
+		return "", fmt.Errorf("decoding path from %s: %+v", id, err)
+	}
+
+	return path, nil
+}
+
+// storageDataLakeGen2PathRecursiveAclDataPlanePath returns the path used in Data Lake Gen2 API requests, where the root of
+// the filesystem is an empty path
+func storageDataLakeGen2PathRecursiveAclDataPlanePath(path string) string {
+	if path == storageDataLakeGen2PathRecursiveAclRootPath {
+		return ""
+	}
+	return path
+}
@@ -0,0 +1,323 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/datalakestore/paths"
+)
+
+type StorageDataLakeGen2PathRecursiveAclResource struct{}
+
+func TestAccStorageDataLakeGen2PathRecursiveAcl_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_recursive_acl", "test")
+	r := StorageDataLakeGen2PathRecursiveAclResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("divergent_paths.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("ace"),
+	})
+}
+
+func TestAccStorageDataLakeGen2PathRecursiveAcl_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_recursive_acl", "test")
+	r := StorageDataLakeGen2PathRecursiveAclResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageDataLakeGen2PathRecursiveAcl_root(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_recursive_acl", "test")
+	r := StorageDataLakeGen2PathRecursiveAclResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.root(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("path").HasValue("/"),
+				check.That(data.ResourceName).Key("divergent_paths.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("ace"),
+	})
+}
+
+func TestAccStorageDataLakeGen2PathRecursiveAcl_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path_recursive_acl", "test")
+	r := StorageDataLakeGen2PathRecursiveAclResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("ace"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("divergent_paths.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("ace", "batch_size", "continue_on_failure", "divergence_check_path_limit", "mode"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("divergent_paths.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("ace"),
+	})
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageDataLakeGen2PathRecursiveAclID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := url.PathUnescape(id.RecursiveAclName)
+	if err != nil {
+		return nil, err
+	}
+	if path == "/" {
+		path = ""
+	}
+
+	account, err := client.Storage.GetAccount(ctx, commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Storage Account for %s", id)
+	}
+
+	pathsClient, err := client.Storage.DataLakePathsDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Data Lake Gen2 Paths Client: %+v", err)
+	}
+
+	resp, err := pathsClient.GetProperties(ctx, id.ContainerName, path, paths.GetPropertiesInput{Action: paths.GetPropertiesActionGetAccessControl})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving ACL for %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_recursive_acl" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = azurerm_storage_data_lake_gen2_path.parent.path
+
+  ace {
+    type        = "user"
+    id          = azurerm_user_assigned_identity.test.principal_id
+    permissions = "r-x"
+  }
+
+  depends_on = [
+    azurerm_storage_data_lake_gen2_path.child,
+  ]
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_recursive_acl" "import" {
+  storage_account_id = azurerm_storage_data_lake_gen2_path_recursive_acl.test.storage_account_id
+  filesystem_name    = azurerm_storage_data_lake_gen2_path_recursive_acl.test.filesystem_name
+  path               = azurerm_storage_data_lake_gen2_path_recursive_acl.test.path
+
+  ace {
+    type        = "user"
+    id          = azurerm_user_assigned_identity.test.principal_id
+    permissions = "rwx"
+  }
+}
+`, r.basic(data))
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) root(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_recursive_acl" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "/"
+
+  ace {
+    type        = "user"
+    id          = azurerm_user_assigned_identity.test.principal_id
+    permissions = "r-x"
+  }
+
+  ace {
+    scope       = "default"
+    type        = "user"
+    id          = azurerm_user_assigned_identity.test.principal_id
+    permissions = "r-x"
+  }
+
+  depends_on = [
+    azurerm_storage_data_lake_gen2_path.child,
+  ]
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_data_lake_gen2_path_recursive_acl" "test" {
+  storage_account_id          = azurerm_storage_account.test.id
+  filesystem_name             = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path                        = azurerm_storage_data_lake_gen2_path.parent.path
+  mode                        = "set"
+  batch_size                  = 1
+  continue_on_failure         = true
+  divergence_check_path_limit = 10
+
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+
+  ace {
+    type        = "group"
+    permissions = "r-x"
+  }
+
+  ace {
+    type        = "mask"
+    permissions = "rwx"
+  }
+
+  ace {
+    type        = "other"
+    permissions = "---"
+  }
+
+  ace {
+    type        = "user"
+    id          = azurerm_user_assigned_identity.test.principal_id
+    permissions = "rwx"
+  }
+
+  ace {
+    scope       = "default"
+    type        = "user"
+    id          = azurerm_user_assigned_identity.test.principal_id
+    permissions = "r-x"
+  }
+
+  depends_on = [
+    azurerm_storage_data_lake_gen2_path.child,
+  ]
+}
+`, r.template(data))
+}
+
+func (r StorageDataLakeGen2PathRecursiveAclResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  is_hns_enabled           = true
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Owner"
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_storage_data_lake_gen2_filesystem" "test" {
+  name               = "fstest"
+  storage_account_id = azurerm_storage_account.test.id
+
+  depends_on = [
+    azurerm_role_assignment.test,
+  ]
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "parent" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "parent"
+  resource           = "directory"
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "child" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "${azurerm_storage_data_lake_gen2_path.parent.path}/child"
+  resource           = "directory"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,586 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-08-01/fileshares"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// storageShareDirectorySyncDefaultName is used as the name segment of the ID when syncing to the root of the share
+const storageShareDirectorySyncDefaultName = "default"
+
+type StorageShareDirectorySyncResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageShareDirectorySyncResource{}
+
+var _ sdk.ResourceWithCustomizeDiff = StorageShareDirectorySyncResource{}
+
+type StorageShareDirectorySyncModel struct {
+	StorageShareId        string `tfschema:"storage_share_id"`
+	SourceDirectory       string `tfschema:"source_directory"`
+	Path                  string `tfschema:"path"`
+	DeleteExtraneousFiles bool   `tfschema:"delete_extraneous_files"`
+	Parallelism           int64  `tfschema:"parallelism"`
+	ManifestHash          string `tfschema:"manifest_hash"`
+	RemoteManifestHash    string `tfschema:"remote_manifest_hash"`
+}
+
+func (r StorageShareDirectorySyncResource) ResourceType() string {
+	return "azurerm_storage_share_directory_sync"
+}
+
+func (r StorageShareDirectorySyncResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageShareDirectorySyncID
+}
+
+func (r StorageShareDirectorySyncResource) ModelObject() interface{} {
+	return &StorageShareDirectorySyncModel{}
+}
+
+func (r StorageShareDirectorySyncResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_share_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: fileshares.ValidateShareID,
+		},
+
+		"source_directory": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"path": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[^/](.*[^/])?$`),
+				"`path` must not start or end with a `/`",
+			),
+		},
+
+		"delete_extraneous_files": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"parallelism": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      8,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+}
+
+func (r StorageShareDirectorySyncResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"manifest_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"remote_manifest_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageShareDirectorySyncResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			// the directory may be populated by another resource during the apply, in which case it can't be hashed yet
+			if !diff.NewValueKnown("source_directory") || !diff.NewValueKnown("path") {
+				return diff.SetNewComputed("manifest_hash")
+			}
+
+			sourceDirectory := diff.Get("source_directory").(string)
+			if _, err := os.Stat(sourceDirectory); err != nil {
+				if os.IsNotExist(err) {
+					return diff.SetNewComputed("manifest_hash")
+				}
+				return fmt.Errorf("checking `source_directory` %q: %+v", sourceDirectory, err)
+			}
+
+			local, _, err := shareDirectorySyncLocalFiles(sourceDirectory, diff.Get("path").(string))
+			if err != nil {
+				return err
+			}
+
+			manifestHash := directorySyncManifestHash(shareDirectorySyncContentMD5s(local))
+			if diff.Get("manifest_hash").(string) != manifestHash {
+				return diff.SetNew("manifest_hash", manifestHash)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageShareDirectorySyncResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var model StorageShareDirectorySyncModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			shareId, err := fileshares.ParseShareID(model.StorageShareId)
+			if err != nil {
+				return err
+			}
+
+			name := storageShareDirectorySyncDefaultName
+			if model.Path != "" {
+				name = url.PathEscape(model.Path)
+			}
+
+			id := parse.NewStorageShareDirectorySyncID(shareId.SubscriptionId, shareId.ResourceGroupName, shareId.StorageAccountName, "default", shareId.ShareName, name)
+
+			account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(shareId.SubscriptionId, shareId.ResourceGroupName, shareId.StorageAccountName))
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q for %s", shareId.StorageAccountName, id)
+			}
+
+			sync, err := r.client(ctx, metadata, *account, shareId.ShareName, int(model.Parallelism))
+			if err != nil {
+				return fmt.Errorf("building clients for %s: %+v", id, err)
+			}
+
+			// all files and directories beneath the path are managed by this resource, so any existing contents could be
+			// deleted when these don't exist in the source directory, and when this resource is deleted
+			pathExists := true
+			if model.Path != "" {
+				resp, err := sync.DirectoriesClient.Get(ctx, shareId.ShareName, model.Path)
+				if err != nil {
+					if !response.WasNotFound(resp.HttpResponse) {
+						return fmt.Errorf("checking for existing directory %q for %s: %+v", model.Path, id, err)
+					}
+					pathExists = false
+				}
+			}
+
+			if pathExists && !metadata.Client.Features.SkipImportCheckOnCreateAndAllowOverwritingExistingResources {
+				existingFiles, existingDirectories, err := sync.List(ctx, model.Path)
+				if err != nil {
+					return fmt.Errorf("checking for existing files for %s: %+v", id, err)
+				}
+				if len(existingFiles) > 0 || len(existingDirectories) > 0 {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			if err := r.sync(ctx, metadata, *shareId, &model, true); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageShareDirectorySyncResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageShareDirectorySyncID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state StorageShareDirectorySyncModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			targetPath, err := storageShareDirectorySyncPath(*id)
+			if err != nil {
+				return err
+			}
+
+			state.StorageShareId = fileshares.NewShareID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ShareName).ID()
+			state.Path = targetPath
+			if state.Parallelism == 0 {
+				state.Parallelism = 8
+			}
+
+			account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName))
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			sharesClient, err := storageClient.FileSharesDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building File Shares Client for %s: %+v", id, err)
+			}
+
+			exists, err := sharesClient.Exists(ctx, id.ShareName)
+			if err != nil {
+				return fmt.Errorf("checking for existing Share for %s: %+v", id, err)
+			}
+			if !pointer.From(exists) {
+				return metadata.MarkAsGone(id)
+			}
+
+			sync, err := r.client(ctx, metadata, *account, id.ShareName, int(state.Parallelism))
+			if err != nil {
+				return fmt.Errorf("building clients for %s: %+v", id, err)
+			}
+
+			remote := make(map[string]string)
+			pathExists := true
+			if targetPath != "" {
+				resp, err := sync.DirectoriesClient.Get(ctx, id.ShareName, targetPath)
+				if err != nil {
+					if !response.WasNotFound(resp.HttpResponse) {
+						return fmt.Errorf("retrieving directory %q for %s: %+v", targetPath, id, err)
+					}
+					pathExists = false
+				}
+			}
+
+			if pathExists {
+				remote, _, err = sync.List(ctx, targetPath)
+				if err != nil {
+					return fmt.Errorf("listing files for %s: %+v", id, err)
+				}
+			}
+
+			// the content MD5 isn't included when listing files, so changes made outside of Terraform are detected using
+			// their ETags - clearing the manifest hash means the local files are synced again, since these are compared
+			// against it in CustomizeDiff
+			remoteManifestHash := directorySyncManifestHash(remote)
+			if state.RemoteManifestHash != "" && state.RemoteManifestHash != remoteManifestHash {
+				state.ManifestHash = ""
+			}
+			state.RemoteManifestHash = remoteManifestHash
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageShareDirectorySyncResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.StorageShareDirectorySyncID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageShareDirectorySyncModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			if !metadata.ResourceData.HasChanges("source_directory", "delete_extraneous_files", "manifest_hash") {
+				return nil
+			}
+
+			shareId := fileshares.NewShareID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ShareName)
+
+			if err := r.sync(ctx, metadata, shareId, &model, false); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageShareDirectorySyncResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageShareDirectorySyncID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageShareDirectorySyncModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			targetPath, err := storageShareDirectorySyncPath(*id)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName))
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+			}
+			if account == nil {
+				return nil
+			}
+
+			sharesClient, err := storageClient.FileSharesDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building File Shares Client for %s: %+v", id, err)
+			}
+
+			exists, err := sharesClient.Exists(ctx, id.ShareName)
+			if err != nil {
+				return fmt.Errorf("checking for existing Share for %s: %+v", id, err)
+			}
+			if !pointer.From(exists) {
+				return nil
+			}
+
+			sync, err := r.client(ctx, metadata, *account, id.ShareName, int(model.Parallelism))
+			if err != nil {
+				return fmt.Errorf("building clients for %s: %+v", id, err)
+			}
+
+			if targetPath != "" {
+				resp, err := sync.DirectoriesClient.Get(ctx, id.ShareName, targetPath)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return nil
+					}
+					return fmt.Errorf("retrieving directory %q for %s: %+v", targetPath, id, err)
+				}
+			}
+
+			files, directoryNames, err := sync.List(ctx, targetPath)
+			if err != nil {
+				return fmt.Errorf("listing files for %s: %+v", id, err)
+			}
+
+			fileNames := make([]string, 0, len(files))
+			for name := range files {
+				fileNames = append(fileNames, name)
+			}
+			sort.Strings(fileNames)
+
+			// when extraneous files are kept only the files which were synced from the source directory are removed,
+			// the directories are left in place since they may still contain other files
+			if !model.DeleteExtraneousFiles {
+				directoryNames = make([]string, 0)
+				if _, err := os.Stat(model.SourceDirectory); err != nil {
+					return nil
+				}
+
+				local, _, err := shareDirectorySyncLocalFiles(model.SourceDirectory, targetPath)
+				if err != nil {
+					return err
+				}
+
+				managed := make([]string, 0)
+				for _, name := range fileNames {
+					if _, ok := local[name]; ok {
+						managed = append(managed, name)
+					}
+				}
+				fileNames = managed
+			}
+
+			if err := sync.DeleteFiles(ctx, fileNames); err != nil {
+				return fmt.Errorf("deleting files for %s: %+v", id, err)
+			}
+
+			if err := sync.DeleteDirectories(ctx, directoryNames); err != nil {
+				return fmt.Errorf("deleting directories for %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// sync creates the directories and uploads the files in the source directory which differ from those under the path,
+// optionally removing any files and directories which no longer exist in the source directory, then sets the manifest
+// hashes of the synced content on the model
+func (r StorageShareDirectorySyncResource) sync(ctx context.Context, metadata sdk.ResourceMetaData, shareId fileshares.ShareId, model *StorageShareDirectorySyncModel, uploadAll bool) error {
+	storageClient := metadata.Client.Storage
+
+	local, localDirectoryNames, err := shareDirectorySyncLocalFiles(model.SourceDirectory, model.Path)
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(shareId.SubscriptionId, shareId.ResourceGroupName, shareId.StorageAccountName))
+	if err != nil {
+		return fmt.Errorf("retrieving Storage Account: %+v", err)
+	}
+	if account == nil {
+		return fmt.Errorf("locating Storage Account %q", shareId.StorageAccountName)
+	}
+
+	sync, err := r.client(ctx, metadata, *account, shareId.ShareName, int(model.Parallelism))
+	if err != nil {
+		return err
+	}
+
+	// the path needs to exist before its contents can be listed
+	parentDirectoryNames := shareDirectorySyncParents(model.Path)
+	if err := sync.CreateDirectories(ctx, parentDirectoryNames); err != nil {
+		return fmt.Errorf("creating path %q: %+v", model.Path, err)
+	}
+
+	parentDirectories := make(map[string]struct{}, len(parentDirectoryNames))
+	for _, name := range parentDirectoryNames {
+		parentDirectories[name] = struct{}{}
+	}
+
+	remoteFiles, remoteDirectoryNames, err := sync.List(ctx, model.Path)
+	if err != nil {
+		return fmt.Errorf("listing files: %+v", err)
+	}
+
+	remoteDirectories := make(map[string]struct{}, len(remoteDirectoryNames))
+	for _, name := range remoteDirectoryNames {
+		remoteDirectories[name] = struct{}{}
+	}
+
+	directoryCreations := make([]string, 0)
+	localDirectories := make(map[string]struct{}, len(localDirectoryNames))
+	for _, name := range localDirectoryNames {
+		localDirectories[name] = struct{}{}
+		_, isParent := parentDirectories[name]
+		if _, ok := remoteDirectories[name]; !ok && !isParent {
+			directoryCreations = append(directoryCreations, name)
+		}
+	}
+
+	existing := make([]string, 0)
+	fileDeletions := make([]string, 0)
+	for name := range remoteFiles {
+		if _, ok := local[name]; ok {
+			existing = append(existing, name)
+		} else if model.DeleteExtraneousFiles {
+			fileDeletions = append(fileDeletions, name)
+		}
+	}
+
+	directoryDeletions := make([]string, 0)
+	if model.DeleteExtraneousFiles {
+		for _, name := range remoteDirectoryNames {
+			if _, ok := localDirectories[name]; !ok {
+				directoryDeletions = append(directoryDeletions, name)
+			}
+		}
+	}
+
+	remote := make(map[string]string)
+	if !uploadAll {
+		remote, err = sync.ContentMD5s(ctx, existing)
+		if err != nil {
+			return fmt.Errorf("retrieving files: %+v", err)
+		}
+	}
+
+	uploads := make([]shareDirectorySyncFile, 0)
+	for name, file := range local {
+		if contentMD5, ok := remote[name]; uploadAll || !ok || contentMD5 != file.ContentMD5 {
+			uploads = append(uploads, file)
+		}
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		return uploads[i].FileName < uploads[j].FileName
+	})
+
+	if err := sync.CreateDirectories(ctx, directoryCreations); err != nil {
+		return fmt.Errorf("creating %d directories: %+v", len(directoryCreations), err)
+	}
+
+	if err := sync.Upload(ctx, uploads); err != nil {
+		return fmt.Errorf("uploading %d changed files: %+v", len(uploads), err)
+	}
+
+	if err := sync.DeleteFiles(ctx, fileDeletions); err != nil {
+		return fmt.Errorf("deleting %d extraneous files: %+v", len(fileDeletions), err)
+	}
+
+	if err := sync.DeleteDirectories(ctx, directoryDeletions); err != nil {
+		return fmt.Errorf("deleting %d extraneous directories: %+v", len(directoryDeletions), err)
+	}
+
+	// the ETags of the files change as they're uploaded, so the files are listed again
+	remoteFiles, _, err = sync.List(ctx, model.Path)
+	if err != nil {
+		return fmt.Errorf("listing files: %+v", err)
+	}
+
+	model.ManifestHash = directorySyncManifestHash(shareDirectorySyncContentMD5s(local))
+	model.RemoteManifestHash = directorySyncManifestHash(remoteFiles)
+
+	return nil
+}
+
+func (r StorageShareDirectorySyncResource) client(ctx context.Context, metadata sdk.ResourceMetaData, account client.AccountDetails, shareName string, parallelism int) (*ShareDirectorySync, error) {
+	storageClient := metadata.Client.Storage
+
+	directoriesClient, err := storageClient.FileShareDirectoriesDataPlaneClient(ctx, account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building File Share Directories Client: %+v", err)
+	}
+
+	filesClient, err := storageClient.FileShareFilesDataPlaneClient(ctx, account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building File Share Files Client: %+v", err)
+	}
+
+	return &ShareDirectorySync{
+		DirectoriesClient: directoriesClient,
+		FilesClient:       filesClient,
+		ShareName:         shareName,
+		Parallelism:       parallelism,
+	}, nil
+}
+
+func storageShareDirectorySyncPath(id parse.StorageShareDirectorySyncId) (string, error) {
+	if id.DirectorySyncName == storageShareDirectorySyncDefaultName {
+		return "", nil
+	}
+
+	targetPath, err := url.PathUnescape(id.DirectorySyncName)
+	if err != nil {
+		return "", fmt.Errorf("decoding path from %s: %+v", id, err)
+	}
+
+	return targetPath, nil
+}
@@ -0,0 +1,249 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageShareDirectorySyncResource struct{}
+
+func TestAccStorageShareDirectorySync_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_directory_sync", "test")
+	r := StorageShareDirectorySyncResource{}
+
+	sourceDirectory := r.sourceDirectory(t, map[string]string{
+		"index.html":      "<html><body>hello</body></html>",
+		"css/site.css":    "body { color: black; }",
+		"data/empty.json": "",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("manifest_hash").Exists(),
+			),
+		},
+		data.ImportStep("source_directory", "manifest_hash"),
+	})
+}
+
+func TestAccStorageShareDirectorySync_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_directory_sync", "test")
+	r := StorageShareDirectorySyncResource{}
+
+	sourceDirectory := r.sourceDirectory(t, map[string]string{
+		"index.html": "<html><body>hello</body></html>",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, sourceDirectory)
+		}),
+	})
+}
+
+func TestAccStorageShareDirectorySync_path(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_directory_sync", "test")
+	r := StorageShareDirectorySyncResource{}
+
+	sourceDirectory := r.sourceDirectory(t, map[string]string{
+		"index.html":   "<html><body>hello</body></html>",
+		"css/site.css": "body { color: black; }",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("path").HasValue("site/v1"),
+			),
+		},
+		data.ImportStep("source_directory", "manifest_hash", "parallelism", "delete_extraneous_files"),
+	})
+}
+
+func TestAccStorageShareDirectorySync_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_directory_sync", "test")
+	r := StorageShareDirectorySyncResource{}
+
+	sourceDirectory := r.sourceDirectory(t, map[string]string{
+		"index.html":   "<html><body>hello</body></html>",
+		"css/site.css": "body { color: black; }",
+	})
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source_directory", "manifest_hash", "parallelism", "delete_extraneous_files"),
+		{
+			PreConfig: func() {
+				r.writeFiles(t, sourceDirectory, map[string]string{
+					"index.html": "<html><body>hello again</body></html>",
+					"js/site.js": "console.log('hello');",
+					"robots.txt": "User-agent: *",
+				})
+				if err := os.RemoveAll(filepath.Join(sourceDirectory, "css")); err != nil {
+					t.Fatalf("removing directory: %+v", err)
+				}
+			},
+			Config: r.complete(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("source_directory", "manifest_hash", "parallelism", "delete_extraneous_files"),
+	})
+}
+
+func (r StorageShareDirectorySyncResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageShareDirectorySyncID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.GetAccount(ctx, commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Storage Account for %s", id)
+	}
+
+	if id.DirectorySyncName == "default" {
+		sharesClient, err := client.Storage.FileSharesDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+		if err != nil {
+			return nil, fmt.Errorf("building File Shares Client: %+v", err)
+		}
+		return sharesClient.Exists(ctx, id.ShareName)
+	}
+
+	path, err := url.PathUnescape(id.DirectorySyncName)
+	if err != nil {
+		return nil, err
+	}
+
+	directoriesClient, err := client.Storage.FileShareDirectoriesDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building File Share Directories Client: %+v", err)
+	}
+
+	resp, err := directoriesClient.Get(ctx, id.ShareName, path)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving directory for %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r StorageShareDirectorySyncResource) sourceDirectory(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+	r.writeFiles(t, directory, files)
+	return directory
+}
+
+func (r StorageShareDirectorySyncResource) writeFiles(t *testing.T, directory string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(directory, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("creating directory for %q: %+v", name, err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+}
+
+func (r StorageShareDirectorySyncResource) basic(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory_sync" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+  source_directory = %q
+}
+`, r.template(data), sourceDirectory)
+}
+
+func (r StorageShareDirectorySyncResource) requiresImport(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory_sync" "import" {
+  storage_share_id = azurerm_storage_share_directory_sync.test.storage_share_id
+  source_directory = azurerm_storage_share_directory_sync.test.source_directory
+}
+`, r.basic(data, sourceDirectory))
+}
+
+func (r StorageShareDirectorySyncResource) complete(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory_sync" "test" {
+  storage_share_id        = azurerm_storage_share.test.id
+  source_directory        = %q
+  path                    = "site/v1"
+  delete_extraneous_files = true
+  parallelism             = 4
+}
+`, r.template(data), sourceDirectory)
+}
+
+func (r StorageShareDirectorySyncResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name               = "sync"
+  storage_account_id = azurerm_storage_account.test.id
+  quota              = 50
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
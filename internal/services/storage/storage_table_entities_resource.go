@@ -0,0 +1,425 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2025-06-01/tables"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/entities"
+)
+
+type StorageTableEntitiesResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageTableEntitiesResource{}
+
+type StorageTableEntitiesModel struct {
+	StorageTableId string                            `tfschema:"storage_table_id"`
+	PartitionKey   string                            `tfschema:"partition_key"`
+	Entity         []StorageTableEntitiesEntityModel `tfschema:"entity"`
+}
+
+type StorageTableEntitiesEntityModel struct {
+	RowKey     string            `tfschema:"row_key"`
+	Properties map[string]string `tfschema:"properties"`
+}
+
+func (r StorageTableEntitiesResource) ResourceType() string {
+	return "azurerm_storage_table_entities"
+}
+
+func (r StorageTableEntitiesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageTableEntityPartitionID
+}
+
+func (r StorageTableEntitiesResource) ModelObject() interface{} {
+	return &StorageTableEntitiesModel{}
+}
+
+func (r StorageTableEntitiesResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_table_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: tables.ValidateTableID,
+		},
+
+		"partition_key": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"entity": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"row_key": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"properties": {
+						Type:     pluginsdk.TypeMap,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageTableEntitiesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var model StorageTableEntitiesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			tableId, err := tables.ParseTableID(model.StorageTableId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewStorageTableEntityPartitionID(tableId.SubscriptionId, tableId.ResourceGroupName, tableId.StorageAccountName, "default", tableId.TableName, url.PathEscape(model.PartitionKey))
+
+			desired, err := expandStorageTableEntities(model.Entity)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName))
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q for %s", id.StorageAccountName, id)
+			}
+
+			client, err := storageClient.TableEntityDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Table Entity Client for %s: %+v", id, err)
+			}
+
+			existing, err := r.listPartition(ctx, client, id.TableName, model.PartitionKey)
+			if err != nil {
+				return fmt.Errorf("checking for existing entities for %s: %+v", id, err)
+			}
+
+			if !metadata.Client.Features.SkipImportCheckOnCreateAndAllowOverwritingExistingResources && len(existing) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			batch := TableEntityBatch{
+				Client:       client,
+				TableName:    id.TableName,
+				PartitionKey: model.PartitionKey,
+			}
+			if err := batch.Apply(ctx, storageTableEntitiesOperations(existing, desired)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageTableEntityPartitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			partitionKey, err := url.PathUnescape(id.PartitionName)
+			if err != nil {
+				return fmt.Errorf("decoding partition key from %s: %+v", id, err)
+			}
+
+			account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName))
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+			}
+			if account == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			client, err := storageClient.TableEntityDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Table Entity Client for %s: %+v", id, err)
+			}
+
+			existing, err := r.listPartition(ctx, client, id.TableName, partitionKey)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if len(existing) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := StorageTableEntitiesModel{
+				StorageTableId: tables.NewTableID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.TableName).ID(),
+				PartitionKey:   partitionKey,
+				Entity:         flattenStorageTableEntities(existing),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageTableEntityPartitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageTableEntitiesModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			if !metadata.ResourceData.HasChange("entity") {
+				return nil
+			}
+
+			desired, err := expandStorageTableEntities(model.Entity)
+			if err != nil {
+				return err
+			}
+
+			account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName))
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+			}
+			if account == nil {
+				return fmt.Errorf("locating Storage Account %q for %s", id.StorageAccountName, id)
+			}
+
+			client, err := storageClient.TableEntityDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Table Entity Client for %s: %+v", id, err)
+			}
+
+			// the changes are determined from the current entities rather than the state, since the partition may have
+			// been modified outside of Terraform since it was last refreshed
+			existing, err := r.listPartition(ctx, client, id.TableName, model.PartitionKey)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			batch := TableEntityBatch{
+				Client:       client,
+				TableName:    id.TableName,
+				PartitionKey: model.PartitionKey,
+			}
+			if err := batch.Apply(ctx, storageTableEntitiesOperations(existing, desired)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageTableEntitiesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			id, err := parse.StorageTableEntityPartitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			partitionKey, err := url.PathUnescape(id.PartitionName)
+			if err != nil {
+				return fmt.Errorf("decoding partition key from %s: %+v", id, err)
+			}
+
+			account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName))
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+			}
+			if account == nil {
+				return nil
+			}
+
+			client, err := storageClient.TableEntityDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+			if err != nil {
+				return fmt.Errorf("building Table Entity Client for %s: %+v", id, err)
+			}
+
+			existing, err := r.listPartition(ctx, client, id.TableName, partitionKey)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			batch := TableEntityBatch{
+				Client:       client,
+				TableName:    id.TableName,
+				PartitionKey: partitionKey,
+			}
+			if err := batch.Apply(ctx, storageTableEntitiesOperations(existing, nil)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// listPartition returns the flattened properties of every entity within the partition, keyed by Row Key
+func (r StorageTableEntitiesResource) listPartition(ctx context.Context, client *entities.Client, tableName, partitionKey string) (map[string]map[string]interface{}, error) {
+	input := entities.QueryEntitiesInput{
+		Filter:        pointer.To(fmt.Sprintf("PartitionKey eq '%s'", strings.ReplaceAll(partitionKey, "'", "''"))),
+		MetaDataLevel: entities.FullMetaData,
+	}
+
+	result := make(map[string]map[string]interface{})
+	for {
+		resp, err := client.Query(ctx, tableName, input)
+		if err != nil {
+			return nil, fmt.Errorf("querying entities: %+v", err)
+		}
+
+		for _, entity := range resp.Entities {
+			rowKey, ok := entity["RowKey"].(string)
+			if !ok {
+				continue
+			}
+			result[rowKey] = flattenEntity(entity)
+		}
+
+		// the results are paged using continuation headers, which Giovanni doesn't currently expose
+		if resp.HttpResponse == nil {
+			break
+		}
+		nextPartitionKey := resp.HttpResponse.Header.Get("x-ms-continuation-NextPartitionKey")
+		nextRowKey := resp.HttpResponse.Header.Get("x-ms-continuation-NextRowKey")
+		if nextPartitionKey == "" && nextRowKey == "" {
+			break
+		}
+		input.NextPartitionKey = pointer.To(nextPartitionKey)
+		input.NextRowKey = pointer.To(nextRowKey)
+	}
+
+	return result, nil
+}
+
+func expandStorageTableEntities(input []StorageTableEntitiesEntityModel) (map[string]map[string]interface{}, error) {
+	result := make(map[string]map[string]interface{}, len(input))
+	for _, entity := range input {
+		if _, ok := result[entity.RowKey]; ok {
+			return nil, fmt.Errorf("the `row_key` %q is specified in more than one `entity` block", entity.RowKey)
+		}
+
+		properties := make(map[string]interface{}, len(entity.Properties))
+		for k, v := range entity.Properties {
+			properties[k] = v
+		}
+		result[entity.RowKey] = properties
+	}
+
+	return result, nil
+}
+
+func flattenStorageTableEntities(input map[string]map[string]interface{}) []StorageTableEntitiesEntityModel {
+	rowKeys := make([]string, 0, len(input))
+	for rowKey := range input {
+		rowKeys = append(rowKeys, rowKey)
+	}
+	sort.Strings(rowKeys)
+
+	result := make([]StorageTableEntitiesEntityModel, 0, len(input))
+	for _, rowKey := range rowKeys {
+		properties := make(map[string]string, len(input[rowKey]))
+		for k, v := range input[rowKey] {
+			properties[k] = fmt.Sprint(v)
+		}
+		result = append(result, StorageTableEntitiesEntityModel{
+			RowKey:     rowKey,
+			Properties: properties,
+		})
+	}
+
+	return result
+}
+
+// storageTableEntitiesOperations returns the operations required to make the partition match the desired entities,
+// entities which already match are left untouched and entities which aren't desired are deleted
+func storageTableEntitiesOperations(existing, desired map[string]map[string]interface{}) []tableEntityBatchOperation {
+	operations := make([]tableEntityBatchOperation, 0)
+
+	for rowKey, properties := range desired {
+		if current, ok := existing[rowKey]; ok && storageTableEntityPropertiesEqual(current, properties) {
+			continue
+		}
+		operations = append(operations, tableEntityBatchOperation{
+			RowKey: rowKey,
+			Entity: properties,
+		})
+	}
+
+	for rowKey := range existing {
+		if _, ok := desired[rowKey]; !ok {
+			operations = append(operations, tableEntityBatchOperation{
+				RowKey: rowKey,
+			})
+		}
+	}
+
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].RowKey < operations[j].RowKey
+	})
+
+	return operations
+}
+
+func storageTableEntityPropertiesEqual(a, b map[string]interface{}) bool {
+	return maps.EqualFunc(a, b, func(x, y interface{}) bool {
+		return fmt.Sprint(x) == fmt.Sprint(y)
+	})
+}
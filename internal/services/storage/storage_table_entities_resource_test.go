@@ -0,0 +1,264 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/entities"
+)
+
+type StorageTableEntitiesResource struct{}
+
+func TestAccStorageTableEntities_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTableEntities_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageTableEntities_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageTableEntities_multipleBatches(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_table_entities", "test")
+	r := StorageTableEntitiesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.count(data, 250),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("250"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.count(data, 120),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("entity.#").HasValue("120"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageTableEntitiesResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageTableEntityPartitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	partitionKey, err := url.PathUnescape(id.PartitionName)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.GetAccount(ctx, commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Storage Account for %s: %+v", id, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("unable to locate Storage Account for %s", id)
+	}
+
+	entitiesClient, err := client.Storage.TableEntityDataPlaneClient(ctx, *account, client.Storage.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building Table Entity Client: %+v", err)
+	}
+
+	input := entities.QueryEntitiesInput{
+		Filter:        pointer.To(fmt.Sprintf("PartitionKey eq '%s'", strings.ReplaceAll(partitionKey, "'", "''"))),
+		Top:           pointer.To(1),
+		MetaDataLevel: entities.NoMetaData,
+	}
+
+	resp, err := entitiesClient.Query(ctx, id.TableName, input)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(len(resp.Entities) > 0), nil
+}
+
+func (r StorageTableEntitiesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_table_id = azurerm_storage_table.test.id
+  partition_key    = "countries"
+
+  entity {
+    row_key = "GB"
+    properties = {
+      Name = "United Kingdom"
+    }
+  }
+
+  entity {
+    row_key = "NL"
+    properties = {
+      Name = "Netherlands"
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageTableEntitiesResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "import" {
+  storage_table_id = azurerm_storage_table_entities.test.storage_table_id
+  partition_key    = azurerm_storage_table_entities.test.partition_key
+
+  entity {
+    row_key = "GB"
+    properties = {
+      Name = "United Kingdom"
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r StorageTableEntitiesResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_table_id = azurerm_storage_table.test.id
+  partition_key    = "countries"
+
+  entity {
+    row_key = "GB"
+    properties = {
+      Name                 = "United Kingdom"
+      Capital              = "London"
+      Ranking              = "3"
+      "Ranking@odata.type" = "Edm.Int32"
+    }
+  }
+
+  entity {
+    row_key = "FR"
+    properties = {
+      Name = "France"
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r StorageTableEntitiesResource) count(data acceptance.TestData, count int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_table_entities" "test" {
+  storage_table_id = azurerm_storage_table.test.id
+  partition_key    = "numbers"
+
+  dynamic "entity" {
+    for_each = range(%d)
+    content {
+      row_key = format("%%05d", entity.value)
+      properties = {
+        Value = tostring(entity.value)
+      }
+    }
+  }
+}
+`, r.template(data), count)
+}
+
+func (r StorageTableEntitiesResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_table" "test" {
+  name               = "acctestst%[1]d"
+  storage_account_id = azurerm_storage_account.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
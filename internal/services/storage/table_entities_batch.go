@@ -0,0 +1,270 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/table/entities"
+)
+
+// tableEntityBatchMaxOperations is the maximum number of operations permitted within a single Entity Group Transaction
+const tableEntityBatchMaxOperations = 100
+
+type tableEntityBatchOperation struct {
+	RowKey string
+
+	// Entity is the entity which should replace any existing entity with the same Row Key, when nil the entity is deleted
+	Entity map[string]interface{}
+}
+
+// TableEntityBatch applies operations to the entities within a single partition of a table using Entity Group
+// Transactions, which aren't currently supported by Giovanni
+type TableEntityBatch struct {
+	Client *entities.Client
+
+	TableName    string
+	PartitionKey string
+}
+
+// Apply submits the operations in batches of up to 100, each batch is applied atomically but a failure part way
+// through leaves the batches which have already been submitted applied
+func (b TableEntityBatch) Apply(ctx context.Context, operations []tableEntityBatchOperation) error {
+	for start := 0; start < len(operations); start += tableEntityBatchMaxOperations {
+		end := start + tableEntityBatchMaxOperations
+		if end > len(operations) {
+			end = len(operations)
+		}
+
+		if err := b.submit(ctx, operations[start:end]); err != nil {
+			return fmt.Errorf("submitting batch of operations %d to %d: %+v", start+1, end, err)
+		}
+	}
+
+	return nil
+}
+
+func (b TableEntityBatch) submit(ctx context.Context, operations []tableEntityBatchOperation) error {
+	batchId, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("generating batch boundary: %+v", err)
+	}
+	changesetId, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("generating changeset boundary: %+v", err)
+	}
+
+	body, err := b.body(operations, "batch_"+batchId, "changeset_"+changesetId)
+	if err != nil {
+		return err
+	}
+
+	opts := client.RequestOptions{
+		ContentType: fmt.Sprintf("multipart/mixed; boundary=batch_%s", batchId),
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+		},
+		HttpMethod:    http.MethodPost,
+		OptionsObject: tableEntityBatchOptions{},
+		Path:          "/$batch",
+	}
+
+	req, err := b.Client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("building request: %+v", err)
+	}
+
+	if err := req.Marshal(body); err != nil {
+		return fmt.Errorf("marshalling request: %+v", err)
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("executing request: %+v", err)
+	}
+	if resp == nil || resp.Response == nil {
+		return fmt.Errorf("executing request: response was nil")
+	}
+	defer resp.Body.Close()
+
+	return tableEntityBatchResponseError(resp.Header.Get("Content-Type"), resp.Body, operations)
+}
+
+// body builds the multipart payload of an Entity Group Transaction containing a single changeset
+// https://learn.microsoft.com/en-us/rest/api/storageservices/performing-entity-group-transactions
+func (b TableEntityBatch) body(operations []tableEntityBatchOperation, batchBoundary, changesetBoundary string) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+
+	fmt.Fprintf(buffer, "--%s\r\n", batchBoundary)
+	fmt.Fprintf(buffer, "Content-Type: multipart/mixed; boundary=%s\r\n\r\n", changesetBoundary)
+
+	for _, operation := range operations {
+		fmt.Fprintf(buffer, "--%s\r\n", changesetBoundary)
+		buffer.WriteString("Content-Type: application/http\r\n")
+		buffer.WriteString("Content-Transfer-Encoding: binary\r\n\r\n")
+
+		uri := fmt.Sprintf("%s/%s(PartitionKey='%s',RowKey='%s')", strings.TrimSuffix(b.Client.Client.BaseUri, "/"), b.TableName, tableEntityKeyEscape(b.PartitionKey), tableEntityKeyEscape(operation.RowKey))
+
+		if operation.Entity == nil {
+			fmt.Fprintf(buffer, "DELETE %s HTTP/1.1\r\n", uri)
+			buffer.WriteString("Accept: application/json;odata=minimalmetadata\r\n")
+			buffer.WriteString("DataServiceVersion: 3.0;\r\n")
+			buffer.WriteString("If-Match: *\r\n\r\n")
+			continue
+		}
+
+		entity := make(map[string]interface{}, len(operation.Entity)+2)
+		for k, v := range operation.Entity {
+			entity[k] = v
+		}
+		entity["PartitionKey"] = b.PartitionKey
+		entity["RowKey"] = operation.RowKey
+
+		payload, err := json.Marshal(entity)
+		if err != nil {
+			return nil, fmt.Errorf("marshalling entity with Row Key %q: %+v", operation.RowKey, err)
+		}
+
+		fmt.Fprintf(buffer, "PUT %s HTTP/1.1\r\n", uri)
+		buffer.WriteString("Accept: application/json;odata=minimalmetadata\r\n")
+		buffer.WriteString("Content-Type: application/json\r\n")
+		buffer.WriteString("DataServiceVersion: 3.0;\r\n")
+		buffer.WriteString("Prefer: return-no-content\r\n")
+		fmt.Fprintf(buffer, "Content-Length: %d\r\n\r\n", len(payload))
+		buffer.Write(payload)
+		buffer.WriteString("\r\n")
+	}
+
+	fmt.Fprintf(buffer, "--%s--\r\n", changesetBoundary)
+	fmt.Fprintf(buffer, "--%s--\r\n", batchBoundary)
+
+	return buffer.Bytes(), nil
+}
+
+// tableEntityBatchResponseError parses the multipart response of an Entity Group Transaction, returning an error
+// describing the failed operation when the changeset was rejected
+func tableEntityBatchResponseError(contentType string, body io.Reader, operations []tableEntityBatchOperation) error {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return fmt.Errorf("parsing response content type %q: %+v", contentType, err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return fmt.Errorf("expected a multipart response but got %q", mediaType)
+	}
+
+	batchReader := multipart.NewReader(body, params["boundary"])
+	for {
+		batchPart, err := batchReader.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading batch response: %+v", err)
+		}
+
+		partMediaType, partParams, err := mime.ParseMediaType(batchPart.Header.Get("Content-Type"))
+		if err != nil {
+			return fmt.Errorf("parsing batch response part content type: %+v", err)
+		}
+
+		// a changeset is returned as a nested multipart response, whilst an error affecting the whole batch isn't
+		if !strings.HasPrefix(partMediaType, "multipart/") {
+			if err := tableEntityBatchOperationError(batchPart, operations); err != nil {
+				return err
+			}
+			continue
+		}
+
+		changesetReader := multipart.NewReader(batchPart, partParams["boundary"])
+		for {
+			changesetPart, err := changesetReader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return fmt.Errorf("reading changeset response: %+v", err)
+			}
+
+			if err := tableEntityBatchOperationError(changesetPart, operations); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func tableEntityBatchOperationError(part io.Reader, operations []tableEntityBatchOperation) error {
+	resp, err := http.ReadResponse(bufio.NewReader(part), nil)
+	if err != nil {
+		return fmt.Errorf("reading operation response: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading operation response body: %+v", err)
+	}
+
+	var tableError struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message struct {
+				Value string `json:"value"`
+			} `json:"message"`
+		} `json:"odata.error"`
+	}
+	if err := json.Unmarshal(respBody, &tableError); err != nil || tableError.Error.Code == "" {
+		return fmt.Errorf("unexpected status %d with response: %s", resp.StatusCode, respBody)
+	}
+
+	// the message is prefixed with the zero-based index of the failed operation, e.g. `1:The specified resource does not exist.`
+	message := tableError.Error.Message.Value
+	if before, after, ok := strings.Cut(message, ":"); ok {
+		if index, err := strconv.Atoi(before); err == nil && index >= 0 && index < len(operations) {
+			return fmt.Errorf("operation on entity with Row Key %q failed with status %d (%s): %s", operations[index].RowKey, resp.StatusCode, tableError.Error.Code, strings.TrimSpace(after))
+		}
+	}
+
+	return fmt.Errorf("unexpected status %d (%s): %s", resp.StatusCode, tableError.Error.Code, strings.TrimSpace(message))
+}
+
+// tableEntityKeyEscape escapes a Partition or Row Key for use within the path of an entity
+func tableEntityKeyEscape(key string) string {
+	return url.PathEscape(strings.ReplaceAll(key, "'", "''"))
+}
+
+type tableEntityBatchOptions struct{}
+
+func (o tableEntityBatchOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("Accept", "application/json")
+	headers.Append("DataServiceVersion", "3.0;NetFx")
+	headers.Append("MaxDataServiceVersion", "3.0;NetFx")
+	return headers
+}
+
+func (o tableEntityBatchOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (o tableEntityBatchOptions) ToQuery() *client.QueryParams {
+	return nil
+}
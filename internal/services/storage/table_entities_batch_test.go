@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTableEntityBatchResponseError(t *testing.T) {
+	operations := []tableEntityBatchOperation{
+		{
+			RowKey: "first",
+			Entity: map[string]interface{}{},
+		},
+		{
+			RowKey: "second",
+		},
+	}
+
+	testcases := []struct {
+		Name          string
+		Body          string
+		ExpectedError string
+	}{
+		{
+			Name: "Succeeded",
+			Body: strings.Join([]string{
+				"--batchresponse_1",
+				"Content-Type: multipart/mixed; boundary=changesetresponse_1",
+				"",
+				"--changesetresponse_1",
+				"Content-Type: application/http",
+				"Content-Transfer-Encoding: binary",
+				"",
+				"HTTP/1.1 204 No Content",
+				"DataServiceVersion: 1.0;",
+				"",
+				"",
+				"--changesetresponse_1",
+				"Content-Type: application/http",
+				"Content-Transfer-Encoding: binary",
+				"",
+				"HTTP/1.1 204 No Content",
+				"DataServiceVersion: 1.0;",
+				"",
+				"",
+				"--changesetresponse_1--",
+				"--batchresponse_1--",
+				"",
+			}, "\r\n"),
+		},
+		{
+			Name: "Operation Failed",
+			Body: strings.Join([]string{
+				"--batchresponse_1",
+				"Content-Type: multipart/mixed; boundary=changesetresponse_1",
+				"",
+				"--changesetresponse_1",
+				"Content-Type: application/http",
+				"Content-Transfer-Encoding: binary",
+				"",
+				"HTTP/1.1 404 Not Found",
+				"Content-Type: application/json;odata=minimalmetadata;streaming=true;charset=utf-8",
+				"",
+				`{"odata.error":{"code":"ResourceNotFound","message":{"lang":"en-US","value":"1:The specified resource does not exist."}}}`,
+				"--changesetresponse_1--",
+				"--batchresponse_1--",
+				"",
+			}, "\r\n"),
+			ExpectedError: `operation on entity with Row Key "second" failed with status 404 (ResourceNotFound): The specified resource does not exist.`,
+		},
+		{
+			Name: "Batch Failed",
+			Body: strings.Join([]string{
+				"--batchresponse_1",
+				"Content-Type: application/http",
+				"Content-Transfer-Encoding: binary",
+				"",
+				"HTTP/1.1 400 Bad Request",
+				"Content-Type: application/json;odata=minimalmetadata;streaming=true;charset=utf-8",
+				"",
+				`{"odata.error":{"code":"InvalidInput","message":{"lang":"en-US","value":"The batch request body is malformed."}}}`,
+				"--batchresponse_1--",
+				"",
+			}, "\r\n"),
+			ExpectedError: "unexpected status 400 (InvalidInput): The batch request body is malformed.",
+		},
+	}
+
+	for _, tc := range testcases {
+		err := tableEntityBatchResponseError("multipart/mixed; boundary=batchresponse_1", strings.NewReader(tc.Body), operations)
+		if tc.ExpectedError == "" {
+			if err != nil {
+				t.Errorf("%s: expected no error but got %+v", tc.Name, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Errorf("%s: expected an error but didn't get one", tc.Name)
+			continue
+		}
+		if err.Error() != tc.ExpectedError {
+			t.Errorf("%s: expected the error %q but got %q", tc.Name, tc.ExpectedError, err.Error())
+		}
+	}
+}
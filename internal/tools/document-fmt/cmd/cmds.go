@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-fmt/data"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-fmt/generate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-fmt/util"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-fmt/validator"
 	log "github.com/sirupsen/logrus"
@@ -20,7 +21,7 @@ func Make() *cobra.Command {
 	fs := afero.NewOsFs()
 
 	rootCmd := &cobra.Command{
-		Use:   "documentfmt [validate|fix|scaffold]",
+		Use:   "documentfmt [validate|fix|generate|scaffold]",
 		Short: "A small tool to validate provider documentation.",
 		Long:  `A small tool to validate provider documentation based on a set of custom rules. It can also fix most found issues.`,
 	}
@@ -116,6 +117,45 @@ func Make() *cobra.Command {
 		},
 	}
 
+	generateCmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Regenerates the Arguments and Attributes sections of documentation from the schema",
+		Long: `Regenerates the Arguments and Attributes sections of documentation from the schema. Descriptions are taken from the schema,
+falling back to the existing documentation, notes and prose fenced with ` + "`" + generate.PreserveStart + "` and `" + generate.PreserveEnd + "`" + ` are kept.`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			util.InitLogger(flags.Debug)
+			validateProviderDirectoryAccess(fs)
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			resources := data.GetAllTerraformNodeData(fs, flags.ProviderDirectory, flags.Service, flags.Resource)
+
+			changedCount := 0
+			for _, r := range resources {
+				if err := generate.Run(r); err != nil {
+					log.WithFields(log.Fields{
+						"resource": r.Name,
+						"path":     r.Document.Path,
+						"error":    err,
+					}).Error("generating documentation")
+					continue
+				}
+
+				if r.Document.HasChange {
+					changedCount++
+					if err := r.Document.Write(fs); err != nil {
+						log.WithFields(log.Fields{
+							"resource": r.Name,
+							"path":     r.Document.Path,
+							"error":    err,
+						}).Error("writing changes to the documentation file")
+					}
+				}
+			}
+
+			fmt.Print(util.GreenBold(fmt.Sprintf("Regenerated documentation for %d resources, please review the changes\n", changedCount)))
+		},
+	}
+
 	scaffoldCmd := &cobra.Command{
 		Use:   "scaffold",
 		Short: "Scaffolds new resource documentation (Not Implemented)",
@@ -128,7 +168,7 @@ func Make() *cobra.Command {
 		},
 	}
 
-	rootCmd.AddCommand(validateCmd, fixCmd, scaffoldCmd)
+	rootCmd.AddCommand(validateCmd, fixCmd, generateCmd, scaffoldCmd)
 
 	configureFlags(rootCmd)
 
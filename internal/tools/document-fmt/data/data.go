@@ -5,6 +5,7 @@ package data
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-fmt/util"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/afero"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
 )
 
 // TerraformNodeData contains all data for a resource that may be required for validation/scaffolding
@@ -30,6 +33,7 @@ type TerraformNodeData struct {
 
 	APIs     []API     // APIs used by this resource -- best effort, may not be populated
 	Timeouts []Timeout // Timeouts from *schema.Resource
+	Blocks   []Block   // Arguments and Attributes from *schema.Resource, the first block is the top level of the resource
 
 	Document *markdown.Document // resource document
 
@@ -214,6 +218,7 @@ func GetAllTerraformNodeData(fs afero.Fs, providerDir string, serviceName string
 func (rd *TerraformNodeData) populateAdditionalFields(fs afero.Fs) {
 	rd.populateAPIData()
 	rd.populateTimeouts()
+	rd.populateBlocks()
 	rd.populateDocumentData(fs)
 }
 
@@ -233,6 +238,18 @@ func (rd *TerraformNodeData) populateDocumentData(fs afero.Fs) {
 	}
 }
 
+func (rd *TerraformNodeData) populateBlocks() {
+	rd.Blocks = blocksFromSchema(rd.Resource.SchemaMap())
+
+	if rd.Type != ResourceTypeEphemeral && rd.Blocks[0].Find("id") == nil {
+		id := Property{
+			Name:        "id",
+			Description: fmt.Sprintf("The ID of the %s.", cases.Title(language.English).String(strings.ReplaceAll(rd.ShortName, "_", " "))),
+		}
+		rd.Blocks[0].Attributes = slices.Insert(rd.Blocks[0].Attributes, 0, id)
+	}
+}
+
 func (rd *TerraformNodeData) populateTimeouts() {
 	if t := rd.Resource.Timeouts; t != nil {
 		if t.Create != nil {
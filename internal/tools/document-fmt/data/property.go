@@ -0,0 +1,379 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package data
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	log "github.com/sirupsen/logrus"
+)
+
+// Property is a single argument or attribute of a resource, or of a block nested within a resource
+type Property struct {
+	Name     string
+	Block    string // name of the nested block this property is, empty if this isn't a block
+	Required bool
+	Optional bool
+	ForceNew bool
+	Default  string // formatted default value, empty if there is no default
+
+	PossibleValues []string
+
+	// Description is taken from the schema, or from the existing documentation when the schema has no description
+	Description string
+}
+
+// IsArgument returns whether the property can be specified in configuration
+func (p Property) IsArgument() bool {
+	return p.Required || p.Optional
+}
+
+// String returns the documentation line for the property, e.g.
+// * `name` - (Required) The name of the Example. Changing this forces a new resource to be created.
+func (p Property) String() string {
+	sentences := make([]string, 0)
+	if p.Description != "" {
+		sentences = append(sentences, p.Description)
+	} else if p.Block != "" {
+		sentences = append(sentences, fmt.Sprintf("%s `%s` block as defined below.", article(p.Block), p.Block))
+	}
+
+	if v := p.possibleValues(); v != "" {
+		sentences = append(sentences, v)
+	}
+
+	if p.Default != "" {
+		sentences = append(sentences, fmt.Sprintf("Defaults to `%s`.", p.Default))
+	}
+
+	if p.ForceNew {
+		sentences = append(sentences, "Changing this forces a new resource to be created.")
+	}
+
+	if !p.IsArgument() {
+		return strings.TrimSpace(fmt.Sprintf("* `%s` - %s", p.Name, strings.Join(sentences, " ")))
+	}
+
+	qualifier := "Optional"
+	if p.Required {
+		qualifier = "Required"
+	}
+
+	return strings.TrimSpace(fmt.Sprintf("* `%s` - (%s) %s", p.Name, qualifier, strings.Join(sentences, " ")))
+}
+
+func (p Property) possibleValues() string {
+	switch len(p.PossibleValues) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf("The only possible value is `%s`.", p.PossibleValues[0])
+	}
+
+	values := make([]string, 0, len(p.PossibleValues))
+	for _, v := range p.PossibleValues {
+		values = append(values, fmt.Sprintf("`%s`", v))
+	}
+
+	return fmt.Sprintf("Possible values are %s and %s.", strings.Join(values[:len(values)-1], ", "), values[len(values)-1])
+}
+
+// Block is a group of properties documented together, the top level of the resource is a Block with an empty Name
+type Block struct {
+	Name string
+	// Parent is the path of the block this is nested within (e.g. `log_file.settings`), this is only set when blocks
+	// with the same name but different schemas are nested within different blocks, so that each can be documented
+	Parent     string
+	Arguments  []Property
+	Attributes []Property
+}
+
+// Key returns the name used to refer to the block within the documentation, which is qualified with the path of the
+// parent block when there are multiple blocks with the same name
+func (b Block) Key() string {
+	if b.Parent == "" {
+		return b.Name
+	}
+	return b.Parent + "." + b.Name
+}
+
+// header returns the line introducing the block within the documentation, e.g.
+// A `settings` block within the `log_file` block supports the following:
+func (b Block) header(verb string) string {
+	if b.Parent == "" {
+		return fmt.Sprintf("%s `%s` block %s the following:", article(b.Name), b.Name, verb)
+	}
+	return fmt.Sprintf("%s `%s` block within the `%s` block %s the following:", article(b.Name), b.Name, b.Parent, verb)
+}
+
+// Find returns the property with the specified name, or nil if it doesn't exist within the block
+func (b *Block) Find(name string) *Property {
+	for _, props := range [][]Property{b.Arguments, b.Attributes} {
+		for idx := range props {
+			if props[idx].Name == name {
+				return &props[idx]
+			}
+		}
+	}
+
+	return nil
+}
+
+// blocksFromSchema returns the top level block followed by every nested block, in the order they should be documented.
+// Nested blocks are documented once per name, as is done throughout the documentation - unless blocks with the same
+// name have different schemas, in which case each is documented along with the path of the block it's nested within
+func blocksFromSchema(s map[string]*schema.Schema) []Block {
+	type pending struct {
+		name     string
+		path     string // the path of the parent block, empty for the top level and blocks nested directly within it
+		schema   map[string]*schema.Schema
+		computed bool // whether the parent block is Computed only, so everything within it is an attribute
+	}
+
+	found := make([]Block, 0)
+	queue := []pending{{schema: s}}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		block := Block{Name: current.name, Parent: current.path}
+		for _, name := range sortedKeys(current.schema) {
+			item := current.schema[name]
+			prop := propertyFromSchema(name, item)
+			if current.computed {
+				prop.Required, prop.Optional, prop.ForceNew, prop.Default, prop.PossibleValues = false, false, false, "", nil
+			}
+
+			if prop.IsArgument() {
+				block.Arguments = append(block.Arguments, prop)
+			} else {
+				block.Attributes = append(block.Attributes, prop)
+			}
+
+			if elem, ok := item.Elem.(*schema.Resource); ok {
+				queue = append(queue, pending{
+					name:     name,
+					path:     strings.TrimPrefix(current.path+"."+current.name, "."),
+					schema:   elem.SchemaMap(),
+					computed: !prop.IsArgument(),
+				})
+			}
+		}
+
+		sortArguments(block.Arguments, current.name == "")
+		found = append(found, block)
+	}
+
+	// blocks with the same name and properties are only documented once
+	variants := make(map[string][]Block)
+	for _, block := range found {
+		if !slices.ContainsFunc(variants[block.Name], block.hasSameProperties) {
+			variants[block.Name] = append(variants[block.Name], block)
+		}
+	}
+
+	result := make([]Block, 0)
+	for _, block := range found {
+		if slices.ContainsFunc(result, func(b Block) bool { return b.Name == block.Name && b.hasSameProperties(block) }) {
+			continue
+		}
+
+		if len(variants[block.Name]) == 1 {
+			block.Parent = ""
+		}
+		result = append(result, block)
+	}
+
+	return result
+}
+
+func (b Block) hasSameProperties(other Block) bool {
+	return reflect.DeepEqual(b.Arguments, other.Arguments) && reflect.DeepEqual(b.Attributes, other.Attributes)
+}
+
+func propertyFromSchema(name string, item *schema.Schema) Property {
+	prop := Property{
+		Name:        name,
+		Required:    item.Required,
+		Optional:    item.Optional,
+		ForceNew:    item.ForceNew,
+		Description: strings.TrimSpace(item.Description),
+	}
+
+	if _, ok := item.Elem.(*schema.Resource); ok {
+		prop.Block = name
+	}
+
+	if item.Default != nil {
+		prop.Default = fmt.Sprintf("%v", item.Default)
+	}
+
+	prop.PossibleValues = possibleValues(item)
+
+	return prop
+}
+
+var (
+	possibleValuesRegex = regexp.MustCompile(`to be one of \[(.*)\], got`)
+	quotedValueRegex    = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+)
+
+// possibleValues returns the values accepted by `validation.StringInSlice`. The validation func is a closure, so the values
+// are retrieved by validating a value that can't be valid and parsing them from the returned error
+func possibleValues(item *schema.Schema) []string {
+	validateFunc := item.ValidateFunc //nolint:staticcheck
+	if validateFunc == nil {
+		if elem, ok := item.Elem.(*schema.Schema); ok {
+			validateFunc = elem.ValidateFunc //nolint:staticcheck
+		}
+	}
+
+	if validateFunc == nil {
+		return nil
+	}
+
+	if fn := runtime.FuncForPC(reflect.ValueOf(validateFunc).Pointer()); fn == nil || !strings.Contains(fn.Name(), "StringInSlice") {
+		return nil
+	}
+
+	_, errs := validateFunc("\x00", "")
+	if len(errs) == 0 {
+		return nil
+	}
+
+	values, ok := parsePossibleValues(errs[0].Error())
+	if !ok {
+		// the format of the error returned by `validation.StringInSlice` has changed, so the values can't be documented
+		log.WithField("error", errs[0].Error()).Warn("unable to parse the possible values from the `validation.StringInSlice` error")
+		return nil
+	}
+
+	return values
+}
+
+// parsePossibleValues parses the sorted values from an error returned by `validation.StringInSlice`, e.g.
+// `expected  to be one of ["Basic" "Standard"], got` and returns whether the error is in the expected format
+func parsePossibleValues(message string) ([]string, bool) {
+	matches := possibleValuesRegex.FindStringSubmatch(message)
+	if len(matches) != 2 {
+		return nil, false
+	}
+
+	values := make([]string, 0)
+	for _, quoted := range quotedValueRegex.FindAllString(matches[1], -1) {
+		v, err := strconv.Unquote(quoted)
+		if err != nil {
+			return nil, false
+		}
+		values = append(values, v)
+	}
+	slices.Sort(values)
+
+	return values, true
+}
+
+// sortArguments sorts Required arguments before Optional ones, the top level `name`, `resource_group_name` and `location`
+// arguments come first and `tags` comes last, matching the order used throughout the documentation
+func sortArguments(props []Property, topLevel bool) {
+	rank := func(p Property) int {
+		if !topLevel {
+			return 0
+		}
+		switch p.Name {
+		case "name":
+			return -3
+		case "resource_group_name":
+			return -2
+		case "location":
+			return -1
+		case "tags":
+			return 1
+		}
+		return 0
+	}
+
+	slices.SortStableFunc(props, func(a, b Property) int {
+		if a.Required != b.Required {
+			if a.Required {
+				return -1
+			}
+			return 1
+		}
+		if ra, rb := rank(a), rank(b); ra != rb {
+			return ra - rb
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+}
+
+func sortedKeys(m map[string]*schema.Schema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// ArgumentLines returns the content of the Arguments section for the resource, excluding the heading
+func (rd *TerraformNodeData) ArgumentLines() []string {
+	result := []string{"The following arguments are supported:", ""}
+
+	for idx, block := range rd.Blocks {
+		if len(block.Arguments) == 0 {
+			continue
+		}
+
+		if idx > 0 {
+			result = append(result, "---", "", block.header("supports"), "")
+		}
+
+		for i, p := range block.Arguments {
+			// the top level Optional arguments are separated from the Required ones
+			if idx == 0 && i > 0 && !p.Required && block.Arguments[i-1].Required {
+				result = append(result, "---", "")
+			}
+			result = append(result, p.String(), "")
+		}
+	}
+
+	return result
+}
+
+// AttributeLines returns the content of the Attributes section for the resource, excluding the heading
+func (rd *TerraformNodeData) AttributeLines() []string {
+	result := []string{"In addition to the Arguments listed above - the following Attributes are exported:", ""}
+
+	for idx, block := range rd.Blocks {
+		if len(block.Attributes) == 0 {
+			continue
+		}
+
+		if idx > 0 {
+			result = append(result, "---", "", block.header("exports"), "")
+		}
+
+		for _, p := range block.Attributes {
+			result = append(result, p.String(), "")
+		}
+	}
+
+	return result
+}
+
+func article(name string) string {
+	if name != "" && strings.ContainsRune("aeiou", rune(name[0])) {
+		return "An"
+	}
+	return "A"
+}
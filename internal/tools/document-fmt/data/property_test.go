@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package data
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkValidation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func TestPossibleValues(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    *schema.Schema
+		Expected []string
+	}{
+		{
+			Name:     "no validation",
+			Input:    &schema.Schema{Type: schema.TypeString},
+			Expected: nil,
+		},
+		{
+			Name: "not StringInSlice",
+			Input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			Expected: nil,
+		},
+		{
+			Name: "StringInSlice",
+			Input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"Standard", "Basic", "Premium"}, false),
+			},
+			Expected: []string{"Basic", "Premium", "Standard"},
+		},
+		{
+			Name: "StringInSlice ignoring case",
+			Input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"Enabled", "Disabled"}, true),
+			},
+			Expected: []string{"Disabled", "Enabled"},
+		},
+		{
+			Name: "StringInSlice from the Plugin SDK",
+			Input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: sdkValidation.StringInSlice([]string{"TCP", "UDP"}, false),
+			},
+			Expected: []string{"TCP", "UDP"},
+		},
+		{
+			Name: "StringInSlice with quotes, commas and spaces",
+			Input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{`say "hello"`, "a, b", "requestContent, responseContent"}, false),
+			},
+			Expected: []string{"a, b", "requestContent, responseContent", `say "hello"`},
+		},
+		{
+			Name: "StringInSlice on the elements of a list",
+			Input: &schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"Read", "Write"}, false),
+				},
+			},
+			Expected: []string{"Read", "Write"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := possibleValues(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestParsePossibleValues(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected []string
+		Ok       bool
+	}{
+		{
+			Input:    `expected  to be one of ["Basic" "Standard"], got ` + "\x00",
+			Expected: []string{"Basic", "Standard"},
+			Ok:       true,
+		},
+		{
+			Input:    `expected sku to be one of [], got `,
+			Expected: []string{},
+			Ok:       true,
+		},
+		{
+			Input:    `expected  to be one of ["a \"quoted\" value"], got `,
+			Expected: []string{`a "quoted" value`},
+			Ok:       true,
+		},
+		{
+			// the format used by `validation.StringInSlice` has changed
+			Input: `value must be one of: Basic, Standard`,
+			Ok:    false,
+		},
+		{
+			Input: `expected type of  to be string`,
+			Ok:    false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, ok := parsePossibleValues(v.Input)
+		if ok != v.Ok {
+			t.Fatalf("Expected ok to be %t but got %t", v.Ok, ok)
+		}
+		if v.Ok && !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestBlocksFromSchema(t *testing.T) {
+	input := map[string]*schema.Schema{
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+		},
+		"sku_name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{"Standard", "Basic"}, false),
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"endpoint": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"network_rule": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"action": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"ip_range": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"identity": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"principal_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"SystemAssigned"}, false),
+					},
+				},
+			},
+		},
+	}
+
+	expected := []Block{
+		{
+			Arguments: []Property{
+				{Name: "name", Required: true, ForceNew: true},
+				{Name: "sku_name", Required: true, PossibleValues: []string{"Basic", "Standard"}},
+				{Name: "enabled", Optional: true, Default: "true"},
+				{Name: "network_rule", Block: "network_rule", Optional: true},
+				{Name: "tags", Optional: true},
+			},
+			Attributes: []Property{
+				{Name: "endpoint"},
+				{Name: "identity", Block: "identity"},
+			},
+		},
+		{
+			Name: "identity",
+			// the block is Computed only, so everything within it is documented as an attribute
+			Attributes: []Property{
+				{Name: "principal_id"},
+				{Name: "type"},
+			},
+		},
+		{
+			Name: "network_rule",
+			Arguments: []Property{
+				{Name: "ip_range", Required: true},
+				{Name: "action", Optional: true},
+			},
+		},
+	}
+
+	actual := blocksFromSchema(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestBlocksFromSchemaWithSameName(t *testing.T) {
+	settings := func(s map[string]*schema.Schema) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Resource{Schema: s},
+		}
+	}
+
+	input := map[string]*schema.Schema{
+		"application_logs": settings(map[string]*schema.Schema{
+			"settings": settings(map[string]*schema.Schema{
+				"level": {Type: schema.TypeString, Required: true},
+			}),
+		}),
+		"http_logs": settings(map[string]*schema.Schema{
+			"settings": settings(map[string]*schema.Schema{
+				"retention_in_days": {Type: schema.TypeInt, Optional: true},
+			}),
+		}),
+		"failed_request_logs": settings(map[string]*schema.Schema{
+			// the same schema as `application_logs.settings`, so this is only documented once
+			"settings": settings(map[string]*schema.Schema{
+				"level": {Type: schema.TypeString, Required: true},
+			}),
+		}),
+	}
+
+	expected := []Block{
+		{
+			Arguments: []Property{
+				{Name: "application_logs", Block: "application_logs", Optional: true},
+				{Name: "failed_request_logs", Block: "failed_request_logs", Optional: true},
+				{Name: "http_logs", Block: "http_logs", Optional: true},
+			},
+		},
+		{
+			Name:      "application_logs",
+			Arguments: []Property{{Name: "settings", Block: "settings", Optional: true}},
+		},
+		{
+			Name:      "failed_request_logs",
+			Arguments: []Property{{Name: "settings", Block: "settings", Optional: true}},
+		},
+		{
+			Name:      "http_logs",
+			Arguments: []Property{{Name: "settings", Block: "settings", Optional: true}},
+		},
+		{
+			Name:      "settings",
+			Parent:    "application_logs",
+			Arguments: []Property{{Name: "level", Required: true}},
+		},
+		{
+			Name:      "settings",
+			Parent:    "http_logs",
+			Arguments: []Property{{Name: "retention_in_days", Optional: true}},
+		},
+	}
+
+	actual := blocksFromSchema(input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	if v := actual[5].header("supports"); v != "A `settings` block within the `http_logs` block supports the following:" {
+		t.Fatalf("unexpected header %q", v)
+	}
+}
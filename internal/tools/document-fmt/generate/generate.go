@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-fmt/data"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-fmt/markdown"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-fmt/template"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// PreserveStart and PreserveEnd fence hand-written prose within the Arguments and Attributes sections, fenced
+	// content is kept after the property it follows when the sections are regenerated
+	PreserveStart = "<!-- document-fmt:preserve-start -->"
+	PreserveEnd   = "<!-- document-fmt:preserve-end -->"
+)
+
+var (
+	blockHeaderRegex = regexp.MustCompile("^An? `(\\w+)` block (?:within the `([\\w.]+)` block )?(?:supports|exports) the following")
+	propertyRegex    = regexp.MustCompile("^\\* `([^`]+)` -\\s*(?:\\((?:Required|Optional)\\)\\s*)?(.*)$")
+
+	// sentences generated from the schema, these are removed from descriptions taken from the existing documentation
+	possibleValuesSentenceRegex = regexp.MustCompile("(?i)\\s*(?:(?:possible|allowed|valid|supported) values (?:are|include)|the only possible value is)(?:[^.`]|`[^`]*`)*\\.")
+	defaultSentenceRegex        = regexp.MustCompile("(?i)\\s*defaults to (?:[^.`]|`[^`]*`)*\\.")
+	forceNewSentenceRegex       = regexp.MustCompile("(?i)\\s*changing this forces a new [^.]* to be created\\.")
+)
+
+// Run regenerates the Arguments and Attributes sections of the documentation from the schema of the resource.
+// Notes and fenced prose are kept, descriptions are taken from the existing documentation when the schema has none
+func Run(rd *data.TerraformNodeData) error {
+	if !rd.Document.Exists || len(rd.Blocks) == 0 {
+		return nil
+	}
+
+	arguments, err := findOrInsertSection(rd, &markdown.ArgumentsSection{}, &markdown.ExampleSection{})
+	if err != nil {
+		return err
+	}
+
+	attributes, err := findOrInsertSection(rd, &markdown.AttributesSection{}, &markdown.ArgumentsSection{})
+	if err != nil {
+		return err
+	}
+
+	existingArguments := parseSection(arguments.GetContent())
+	existingAttributes := parseSection(attributes.GetContent())
+	populateDescriptions(rd.Blocks, existingArguments, existingAttributes)
+
+	for _, s := range []struct {
+		section  markdown.SectionWithTemplate
+		existing parsedSection
+	}{
+		{arguments, existingArguments},
+		{attributes, existingAttributes},
+	} {
+		content, err := template.Render(rd, s.section.Template())
+		if err != nil {
+			return fmt.Errorf("rendering template for `%T`: %+v", s.section, err)
+		}
+
+		content, unused := s.existing.restorePreserved(content)
+		if !slices.Equal(content, s.section.GetContent()) {
+			s.section.SetContent(content)
+			rd.Document.HasChange = true
+		}
+
+		for _, key := range unused {
+			log.WithFields(log.Fields{
+				"name":     rd.Name,
+				"property": strings.TrimPrefix(key, "."),
+			}).Warn("property no longer exists in the schema, preserved prose has been moved to the end of the section")
+		}
+	}
+
+	return nil
+}
+
+func findOrInsertSection(rd *data.TerraformNodeData, section markdown.SectionWithTemplate, after markdown.Section) (markdown.SectionWithTemplate, error) {
+	for _, s := range rd.Document.Sections {
+		if reflect.TypeOf(s) == reflect.TypeOf(section) {
+			return s.(markdown.SectionWithTemplate), nil
+		}
+	}
+
+	sections, err := markdown.InsertAfterSection(section, rd.Document.Sections, after)
+	if err != nil {
+		return nil, fmt.Errorf("inserting `%T`: %+v", section, err)
+	}
+	rd.Document.Sections = sections
+	rd.Document.HasChange = true
+
+	return section, nil
+}
+
+// populateDescriptions uses the description from the existing documentation for properties without a description in
+// the schema, removing any sentences that are generated from the schema
+func populateDescriptions(blocks []data.Block, existing ...parsedSection) {
+	for _, block := range blocks {
+		for _, props := range [][]data.Property{block.Arguments, block.Attributes} {
+			for idx := range props {
+				p := &props[idx]
+				if p.Description != "" {
+					continue
+				}
+
+				for _, e := range existing {
+					if desc, ok := e.descriptions[key(block.Key(), p.Name)]; ok {
+						p.Description = stripGeneratedSentences(desc, *p)
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+func stripGeneratedSentences(desc string, p data.Property) string {
+	if len(p.PossibleValues) > 0 {
+		desc = possibleValuesSentenceRegex.ReplaceAllString(desc, "")
+	}
+
+	if p.Default != "" {
+		desc = defaultSentenceRegex.ReplaceAllString(desc, "")
+	}
+
+	// ForceNew is always generated from the schema, so an existing sentence is either duplicated or incorrect
+	desc = forceNewSentenceRegex.ReplaceAllString(desc, "")
+
+	return strings.TrimSpace(desc)
+}
+
+// blockKey returns the key of the block introduced by the matches of blockHeaderRegex, see `data.Block.Key`
+func blockKey(m []string) string {
+	if m[2] == "" {
+		return m[1]
+	}
+	return m[2] + "." + m[1]
+}
+
+func key(block, property string) string {
+	return block + "." + property
+}
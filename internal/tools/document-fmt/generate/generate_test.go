@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-fmt/data"
+)
+
+func TestStripGeneratedSentences(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Property data.Property
+		Expected string
+	}{
+		{
+			Input:    "The SKU. Possible values are `Basic` and `Standard`.",
+			Property: data.Property{PossibleValues: []string{"Basic", "Standard"}},
+			Expected: "The SKU.",
+		},
+		{
+			// the sentence is only generated when the schema has possible values
+			Input:    "The SKU. Possible values are `Basic` and `Standard`.",
+			Property: data.Property{},
+			Expected: "The SKU. Possible values are `Basic` and `Standard`.",
+		},
+		{
+			Input:    "The mode. The only possible value is `Default`. Defaults to `Default`.",
+			Property: data.Property{PossibleValues: []string{"Default"}, Default: "Default"},
+			Expected: "The mode.",
+		},
+		{
+			Input:    "The version, e.g. `1.2`. Defaults to `1.2`. Changing this forces a new Example to be created.",
+			Property: data.Property{Default: "1.2", ForceNew: true},
+			Expected: "The version, e.g. `1.2`.",
+		},
+		{
+			// an existing ForceNew sentence is always removed since it's generated from the schema
+			Input:    "The name. Changing this forces a new resource to be created.",
+			Property: data.Property{},
+			Expected: "The name.",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual := stripGeneratedSentences(v.Input, v.Property)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestPopulateDescriptions(t *testing.T) {
+	blocks := []data.Block{
+		{
+			Arguments: []data.Property{
+				{Name: "name", Required: true, ForceNew: true},
+				{Name: "sku", Optional: true, Description: "From the schema."},
+			},
+			Attributes: []data.Property{
+				{Name: "id"},
+			},
+		},
+		{
+			Name: "rule",
+			Arguments: []data.Property{
+				{Name: "name", Required: true},
+			},
+		},
+	}
+
+	arguments := parsedSection{
+		descriptions: map[string]string{
+			".name":     "The name of the Example. Changing this forces a new resource to be created.",
+			".sku":      "From the documentation.",
+			"rule.name": "The name of the Rule.",
+		},
+	}
+	attributes := parsedSection{
+		descriptions: map[string]string{
+			".id": "The ID of the Example.",
+		},
+	}
+
+	populateDescriptions(blocks, arguments, attributes)
+
+	for _, v := range []struct {
+		Actual   string
+		Expected string
+	}{
+		{blocks[0].Arguments[0].Description, "The name of the Example."},
+		{blocks[0].Arguments[1].Description, "From the schema."},
+		{blocks[0].Attributes[0].Description, "The ID of the Example."},
+		{blocks[1].Arguments[0].Description, "The name of the Rule."},
+	} {
+		if v.Actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, v.Actual)
+		}
+	}
+}
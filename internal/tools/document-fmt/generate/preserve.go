@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"slices"
+	"strings"
+)
+
+// parsedSection contains the content of an existing Arguments or Attributes section that should survive regeneration
+type parsedSection struct {
+	// descriptions by `<block>.<property>`, the top level block has an empty name
+	descriptions map[string]string
+	// preserved prose by the `<block>.<property>` it follows, `<block>.` when it precedes the first property of a block
+	preserved map[string][][]string
+	order     []string // keys of preserved, in the order they were found
+}
+
+func parseSection(content []string) parsedSection {
+	result := parsedSection{
+		descriptions: map[string]string{},
+		preserved:    map[string][][]string{},
+	}
+
+	block, property := "", ""
+	var chunk []string
+	inFence := false
+
+	addChunk := func() {
+		k := key(block, property)
+		if _, ok := result.preserved[k]; !ok {
+			result.order = append(result.order, k)
+		}
+		result.preserved[k] = append(result.preserved[k], chunk)
+		chunk = nil
+	}
+
+	for _, line := range content {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case inFence:
+			chunk = append(chunk, line)
+			if trimmed == PreserveEnd {
+				inFence = false
+				addChunk()
+			}
+			continue
+		case trimmed == PreserveStart:
+			if chunk != nil {
+				addChunk()
+			}
+			inFence = true
+			chunk = append(chunk, line)
+			continue
+		case isNote(trimmed):
+			if chunk != nil {
+				addChunk()
+			}
+			chunk = append(chunk, line)
+			continue
+		case chunk != nil && trimmed != "" && !propertyRegex.MatchString(line) && !blockHeaderRegex.MatchString(line) && trimmed != "---":
+			// a note may span multiple lines
+			chunk = append(chunk, line)
+			continue
+		case chunk != nil:
+			addChunk()
+		}
+
+		if m := blockHeaderRegex.FindStringSubmatch(line); m != nil {
+			block, property = blockKey(m), ""
+			continue
+		}
+
+		if m := propertyRegex.FindStringSubmatch(line); m != nil {
+			property = m[1]
+			if _, ok := result.descriptions[key(block, property)]; !ok {
+				result.descriptions[key(block, property)] = strings.TrimSpace(m[2])
+			}
+		}
+	}
+
+	if chunk != nil {
+		addChunk()
+	}
+
+	return result
+}
+
+// restorePreserved inserts the preserved prose into the regenerated content after the property it followed, or
+// before the first property of the block when it preceded every property. Prose for properties which no longer exist is
+// added to the end of the section, and the keys of these are returned
+func (p parsedSection) restorePreserved(content []string) (result []string, unused []string) {
+	used := map[string]bool{}
+	block := ""
+
+	insert := func(k string) {
+		for _, chunk := range p.preserved[k] {
+			result = append(result, "")
+			result = append(result, chunk...)
+		}
+		used[k] = true
+	}
+
+	for _, line := range content {
+		if m := blockHeaderRegex.FindStringSubmatch(line); m != nil {
+			block = blockKey(m)
+		}
+
+		if m := propertyRegex.FindStringSubmatch(line); m != nil {
+			// prose preceding the first property of a block
+			if k := key(block, ""); !used[k] {
+				if len(p.preserved[k]) > 0 {
+					result = trimTrailingEmpty(result)
+					insert(k)
+					result = append(result, "")
+				}
+				used[k] = true
+			}
+
+			result = append(result, line)
+			insert(key(block, m[1]))
+			continue
+		}
+
+		result = append(result, line)
+	}
+
+	result = trimTrailingEmpty(result)
+	for _, k := range p.order {
+		if !used[k] {
+			insert(k)
+			unused = append(unused, k)
+		}
+	}
+
+	return append(result, ""), unused
+}
+
+func isNote(line string) bool {
+	return strings.HasPrefix(line, "->") || strings.HasPrefix(line, "~>") || strings.HasPrefix(line, "!>")
+}
+
+func trimTrailingEmpty(lines []string) []string {
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return slices.Clip(lines)
+}
@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package generate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPreserveRoundTrip(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Existing    string
+		Regenerated string
+		Expected    string
+		Unused      []string
+	}{
+		{
+			Name: "nothing to preserve",
+			Existing: `The following arguments are supported:
+
+* ` + "`name`" + ` - (Required) The name.
+`,
+			Regenerated: `The following arguments are supported:
+
+* ` + "`name`" + ` - (Required) The name. Changing this forces a new resource to be created.
+`,
+			Expected: `The following arguments are supported:
+
+* ` + "`name`" + ` - (Required) The name. Changing this forces a new resource to be created.
+`,
+		},
+		{
+			Name: "notes and fences are kept after their property",
+			Existing: `The following arguments are supported:
+
+* ` + "`name`" + ` - (Required) The name.
+
+~> **Note:** A note which spans
+multiple lines.
+
+* ` + "`sku`" + ` - (Optional) The SKU.
+
+<!-- document-fmt:preserve-start -->
+An example:
+
+* ` + "`not_a_property`" + ` - within the fence.
+<!-- document-fmt:preserve-end -->
+
+-> **Note:** A second note.
+
+---
+
+A ` + "`rule`" + ` block supports the following:
+
+-> **Note:** Prose before the first property of the block.
+
+* ` + "`action`" + ` - (Required) The action.
+`,
+			Regenerated: `The following arguments are supported:
+
+* ` + "`name`" + ` - (Required) The name.
+
+* ` + "`sku`" + ` - (Optional) The SKU. Possible values are ` + "`Basic`" + ` and ` + "`Standard`" + `.
+
+---
+
+A ` + "`rule`" + ` block supports the following:
+
+* ` + "`action`" + ` - (Required) The action.
+`,
+			Expected: `The following arguments are supported:
+
+* ` + "`name`" + ` - (Required) The name.
+
+~> **Note:** A note which spans
+multiple lines.
+
+* ` + "`sku`" + ` - (Optional) The SKU. Possible values are ` + "`Basic`" + ` and ` + "`Standard`" + `.
+
+<!-- document-fmt:preserve-start -->
+An example:
+
+* ` + "`not_a_property`" + ` - within the fence.
+<!-- document-fmt:preserve-end -->
+
+-> **Note:** A second note.
+
+---
+
+A ` + "`rule`" + ` block supports the following:
+
+-> **Note:** Prose before the first property of the block.
+
+* ` + "`action`" + ` - (Required) The action.
+`,
+		},
+		{
+			Name: "prose for removed properties is moved to the end",
+			Existing: `The following arguments are supported:
+
+* ` + "`name`" + ` - (Required) The name.
+
+* ` + "`legacy`" + ` - (Optional) Removed.
+
+~> **Note:** About the removed property.
+`,
+			Regenerated: `The following arguments are supported:
+
+* ` + "`name`" + ` - (Required) The name.
+`,
+			Expected: `The following arguments are supported:
+
+* ` + "`name`" + ` - (Required) The name.
+
+~> **Note:** About the removed property.
+`,
+			Unused: []string{".legacy"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		existing := parseSection(strings.Split(v.Existing, "\n"))
+		actual, unused := existing.restorePreserved(trimTrailingEmpty(strings.Split(v.Regenerated, "\n")))
+
+		if expected := strings.Split(v.Expected, "\n"); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("Expected:\n%s\n\nGot:\n%s", v.Expected, strings.Join(actual, "\n"))
+		}
+		if len(unused) != len(v.Unused) || (len(unused) > 0 && !reflect.DeepEqual(unused, v.Unused)) {
+			t.Fatalf("Expected the unused keys %+v but got %+v", v.Unused, unused)
+		}
+	}
+}
+
+func TestParseSectionDescriptions(t *testing.T) {
+	content := strings.Split(`The following arguments are supported:
+
+* `+"`name`"+` - (Required) The name of the Example.
+
+* `+"`rule`"+` - (Optional) A `+"`rule`"+` block as defined below.
+
+---
+
+A `+"`rule`"+` block supports the following:
+
+* `+"`name`"+` - (Required) The name of the Rule.
+
+* `+"`settings`"+` - (Optional) A `+"`settings`"+` block as defined below.
+
+---
+
+A `+"`settings`"+` block within the `+"`rule`"+` block supports the following:
+
+* `+"`level`"+` - (Required) The level of the Rule.
+`, "\n")
+
+	expected := map[string]string{
+		".name":               "The name of the Example.",
+		".rule":               "A `rule` block as defined below.",
+		"rule.name":           "The name of the Rule.",
+		"rule.settings":       "A `settings` block as defined below.",
+		"rule.settings.level": "The level of the Rule.",
+	}
+
+	actual := parseSection(content).descriptions
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
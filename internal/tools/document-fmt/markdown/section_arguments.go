@@ -36,6 +36,10 @@ func (s *ArgumentsSection) GetContent() []string {
 }
 
 func (s *ArgumentsSection) Template() string {
-	// TODO implement me
-	panic("implement me")
+	return `## Arguments Reference
+
+{{ range .ArgumentLines -}}
+{{ . }}
+{{ end -}}
+`
 }
@@ -36,6 +36,10 @@ func (s *AttributesSection) GetContent() []string {
 }
 
 func (s *AttributesSection) Template() string {
-	// TODO implement me
-	panic("implement me")
+	return `## Attributes Reference
+
+{{ range .AttributeLines -}}
+{{ . }}
+{{ end -}}
+`
 }
@@ -0,0 +1,349 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	schema2 "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/util"
+)
+
+// exampleDiff is an issue found when validating the HCL within the `## Example Usage` section against the provider schema
+type exampleDiff struct {
+	checkBase
+	msg          string
+	resourceType string
+}
+
+func newExampleDiff(line int, key, resourceType, msg string) exampleDiff {
+	return exampleDiff{
+		checkBase:    newCheckBase(line, key, nil),
+		msg:          msg,
+		resourceType: resourceType,
+	}
+}
+
+func (e exampleDiff) String() string {
+	if e.Key() == "" {
+		return fmt.Sprintf("%d example %s", e.Line()+1, e.msg)
+	}
+	return fmt.Sprintf("%s example %s", e.Str(), e.msg)
+}
+
+func (e exampleDiff) ShouldSkip() bool {
+	return isSkipProp(e.resourceType, e.Key())
+}
+
+func (e exampleDiff) Fix(line string) (result string, err error) {
+	// examples have to be fixed by hand
+	return line, nil
+}
+
+var _ Checker = exampleDiff{}
+
+// metaArguments are available on every resource and data source, so aren't part of the schema
+var metaArguments = map[string]struct{}{
+	"count":      {},
+	"depends_on": {},
+	"for_each":   {},
+	"provider":   {},
+}
+
+var metaBlocks = map[string]struct{}{
+	"connection":  {},
+	"lifecycle":   {},
+	"provisioner": {},
+}
+
+type exampleValidator struct {
+	resources   map[string]*schema2.Resource
+	dataSources map[string]*schema2.Resource
+
+	resourceType string // the resource being documented
+	diffs        []Checker
+}
+
+var (
+	providerSchemaOnce sync.Once
+	providerResources  map[string]*schema2.Resource
+	providerDatas      map[string]*schema2.Resource
+)
+
+func newExampleValidator(resourceType string) *exampleValidator {
+	providerSchemaOnce.Do(func() {
+		p := provider.AzureProvider()
+		providerResources = p.ResourcesMap
+		providerDatas = p.DataSourcesMap
+	})
+
+	return &exampleValidator{
+		resources:    providerResources,
+		dataSources:  providerDatas,
+		resourceType: resourceType,
+	}
+}
+
+func diffExamples(md *model.ResourceDoc) []Checker {
+	if len(md.Examples) == 0 {
+		return nil
+	}
+
+	v := newExampleValidator(md.ResourceName)
+	v.validate(md.Examples)
+	return v.diffs
+}
+
+func (v *exampleValidator) addDiff(line int, key, format string, args ...interface{}) {
+	// hcl lines are 1-based whereas document lines are 0-based
+	v.diffs = append(v.diffs, newExampleDiff(line-1, key, v.resourceType, fmt.Sprintf(format, args...)))
+}
+
+func (v *exampleValidator) validate(examples []model.Example) {
+	var files []*hclsyntax.Body
+	declared := map[string]struct{}{}
+
+	for _, example := range examples {
+		file, diags := hclsyntax.ParseConfig([]byte(example.HCL), v.resourceType, hcl.Pos{Line: example.Line + 1, Column: 1})
+		if diags.HasErrors() {
+			for _, diag := range diags.Errs() {
+				line := example.Line + 1
+				if d, ok := diag.(*hcl.Diagnostic); ok && d.Subject != nil {
+					line = d.Subject.Start.Line
+				}
+				v.addDiff(line, "", "is not valid HCL: %s", diag.Error())
+			}
+			continue
+		}
+
+		body := file.Body.(*hclsyntax.Body)
+		files = append(files, body)
+
+		// references may be to blocks in any example within the document
+		for _, block := range body.Blocks {
+			if block.Type == "resource" && len(block.Labels) == 2 {
+				declared[strings.Join(block.Labels, ".")] = struct{}{}
+			}
+			if block.Type == "data" && len(block.Labels) == 2 {
+				declared["data."+strings.Join(block.Labels, ".")] = struct{}{}
+			}
+		}
+	}
+
+	for _, body := range files {
+		for _, block := range body.Blocks {
+			v.validateTopLevelBlock(block)
+		}
+		v.validateReferences(body, declared)
+	}
+}
+
+func (v *exampleValidator) validateTopLevelBlock(block *hclsyntax.Block) {
+	var schemas map[string]*schema2.Resource
+	switch block.Type {
+	case "resource":
+		schemas = v.resources
+	case "data":
+		schemas = v.dataSources
+	default:
+		// e.g. provider, variable, locals and output blocks
+		return
+	}
+
+	if len(block.Labels) != 2 {
+		v.addDiff(block.TypeRange.Start.Line, "", "%s block should have a type and a name", block.Type)
+		return
+	}
+
+	typ := block.Labels[0]
+	if !strings.HasPrefix(typ, "azurerm_") {
+		return
+	}
+
+	res, ok := schemas[typ]
+	if !ok {
+		v.addDiff(block.LabelRanges[0].Start.Line, typ, "%s is not supported by the provider", block.Type)
+		return
+	}
+
+	v.validateBody(block.Body, res.SchemaMap(), typ, res.Timeouts, true)
+}
+
+// validateBody checks the arguments and blocks within body exist in the schema, and that the required ones are specified
+func (v *exampleValidator) validateBody(body *hclsyntax.Body, s map[string]*schema2.Schema, path string, timeouts *schema2.ResourceTimeout, topLevel bool) {
+	specified := map[string]struct{}{}
+
+	for name, attr := range body.Attributes {
+		if _, ok := metaArguments[name]; ok && topLevel {
+			continue
+		}
+
+		key := path + "." + name
+		sch, ok := s[name]
+		switch {
+		case !ok:
+			v.addDiff(attr.NameRange.Start.Line, key, "does not exist in the schema")
+		case sch.Computed && !sch.Optional && !sch.Required:
+			v.addDiff(attr.NameRange.Start.Line, key, "is Computed and cannot be set")
+		}
+		specified[name] = struct{}{}
+	}
+
+	for _, block := range body.Blocks {
+		if _, ok := metaBlocks[block.Type]; ok && topLevel {
+			continue
+		}
+
+		if block.Type == "timeouts" && topLevel {
+			v.validateTimeouts(block, path, timeouts)
+			continue
+		}
+
+		name, content := block.Type, block.Body
+		if block.Type == "dynamic" {
+			if len(block.Labels) != 1 {
+				v.addDiff(block.TypeRange.Start.Line, path, "dynamic block should have a name")
+				continue
+			}
+			name, content = block.Labels[0], nil
+			for _, b := range block.Body.Blocks {
+				if b.Type == "content" {
+					content = b.Body
+				}
+			}
+		}
+
+		key := path + "." + name
+		sch, ok := s[name]
+		if !ok {
+			v.addDiff(block.TypeRange.Start.Line, key, "does not exist in the schema")
+			continue
+		}
+		specified[name] = struct{}{}
+
+		elem, ok := sch.Elem.(*schema2.Resource)
+		if !ok {
+			v.addDiff(block.TypeRange.Start.Line, key, "is an argument rather than a block, this should be specified as `%s = ...`", name)
+			continue
+		}
+		if sch.Computed && !sch.Optional && !sch.Required {
+			v.addDiff(block.TypeRange.Start.Line, key, "is Computed and cannot be set")
+			continue
+		}
+
+		if content != nil {
+			v.validateBody(content, elem.SchemaMap(), key, nil, false)
+		}
+	}
+
+	// intentionally sorting these so the output is consistent
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, ok := specified[name]; !ok && s[name].Required {
+			v.addDiff(body.SrcRange.Start.Line, path+"."+name, "is Required but not specified")
+		}
+	}
+}
+
+func (v *exampleValidator) validateTimeouts(block *hclsyntax.Block, path string, timeouts *schema2.ResourceTimeout) {
+	if timeouts == nil {
+		v.addDiff(block.TypeRange.Start.Line, path+".timeouts", "is not supported by this resource")
+		return
+	}
+
+	supported := map[string]bool{
+		"create": timeouts.Create != nil,
+		"read":   timeouts.Read != nil,
+		"update": timeouts.Update != nil,
+		"delete": timeouts.Delete != nil,
+	}
+	for name, attr := range block.Body.Attributes {
+		if !supported[name] {
+			v.addDiff(attr.NameRange.Start.Line, path+".timeouts."+name, "is not a supported timeout")
+		}
+	}
+}
+
+// validateReferences checks that references to other resources and data sources are declared within the example, and
+// that the referenced attribute exists in the schema
+func (v *exampleValidator) validateReferences(body *hclsyntax.Body, declared map[string]struct{}) {
+	_ = hclsyntax.VisitAll(body, func(node hclsyntax.Node) hcl.Diagnostics {
+		expr, ok := node.(*hclsyntax.ScopeTraversalExpr)
+		if !ok {
+			return nil
+		}
+
+		traversal := expr.Traversal
+		schemas, prefix := v.resources, ""
+		if traversal.RootName() == "data" {
+			schemas, prefix, traversal = v.dataSources, "data.", traversal[1:]
+		}
+
+		typ := traversalName(traversal, 0)
+		if !strings.HasPrefix(typ, "azurerm_") {
+			return nil
+		}
+
+		line := expr.SrcRange.Start.Line
+		res, ok := schemas[typ]
+		if !ok {
+			v.addDiff(line, prefix+typ, "is referenced but is not supported by the provider")
+			return nil
+		}
+
+		name := traversalName(traversal, 1)
+		if name == "" {
+			return nil
+		}
+		address := prefix + typ + "." + name
+		if _, ok := declared[address]; !ok {
+			v.addDiff(line, address, "is referenced but is not defined in the example")
+			return nil
+		}
+
+		// skip over any index, e.g. `azurerm_example.test[0].id`
+		idx := 2
+		if idx < len(traversal) {
+			if _, ok := traversal[idx].(hcl.TraverseIndex); ok {
+				idx++
+			}
+		}
+
+		attr := traversalName(traversal, idx)
+		if attr == "" {
+			return nil
+		}
+		if _, ok := res.SchemaMap()[attr]; !ok && attr != "id" {
+			v.addDiff(line, address+"."+attr, "is referenced but %s does not exist in the schema", util.ItalicCode(attr))
+		}
+		return nil
+	})
+}
+
+// traversalName returns the name of the root or attribute step at idx, or an empty string if that isn't a named step
+func traversalName(traversal hcl.Traversal, idx int) string {
+	if idx >= len(traversal) {
+		return ""
+	}
+
+	switch step := traversal[idx].(type) {
+	case hcl.TraverseRoot:
+		return step.Name
+	case hcl.TraverseAttr:
+		return step.Name
+	}
+	return ""
+}
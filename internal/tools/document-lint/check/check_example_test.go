@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+
+	schema2 "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+)
+
+func TestExampleValidator(t *testing.T) {
+	res := &schema2.Resource{
+		Schema: map[string]*schema2.Schema{
+			"name":     {Type: schema2.TypeString, Required: true},
+			"location": {Type: schema2.TypeString, Optional: true},
+			"fqdn":     {Type: schema2.TypeString, Computed: true},
+			"rule": {
+				Type:     schema2.TypeList,
+				Optional: true,
+				Elem: &schema2.Resource{
+					Schema: map[string]*schema2.Schema{
+						"priority": {Type: schema2.TypeInt, Required: true},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		hcl      string
+		wantKeys []string
+	}{
+		{
+			name: "valid",
+			hcl: `resource "azurerm_example" "test" {
+  name = "acctest"
+  rule {
+    priority = 1
+  }
+}
+
+resource "azurerm_example" "other" {
+  name     = azurerm_example.test.fqdn
+  location = azurerm_example.test.location
+}`,
+		},
+		{
+			name: "invalid",
+			hcl: `resource "azurerm_example" "test" {
+  removed = true
+  fqdn    = "example.com"
+  rule {}
+}
+
+resource "azurerm_example" "other" {
+  name = azurerm_example.missing.id
+  location = azurerm_example.test.removed
+}`,
+			wantKeys: []string{
+				"azurerm_example.removed",
+				"azurerm_example.fqdn",
+				"azurerm_example.rule.priority",
+				"azurerm_example.name",
+				"azurerm_example.missing",
+				"azurerm_example.test.removed",
+			},
+		},
+		{
+			name:     "unparsable",
+			hcl:      `resource "azurerm_example" "test" {`,
+			wantKeys: []string{""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &exampleValidator{
+				resources:    map[string]*schema2.Resource{"azurerm_example": res},
+				dataSources:  map[string]*schema2.Resource{},
+				resourceType: "azurerm_example",
+			}
+			v.validate([]model.Example{{Line: 10, HCL: tt.hcl}})
+
+			got := map[string]struct{}{}
+			for _, d := range v.diffs {
+				got[d.Key()] = struct{}{}
+			}
+			if len(got) != len(tt.wantKeys) {
+				t.Fatalf("expected %d diffs, got %d: %v", len(tt.wantKeys), len(v.diffs), v.diffs)
+			}
+			for _, key := range tt.wantKeys {
+				if _, ok := got[key]; !ok {
+					t.Errorf("expected a diff for %q, got %v", key, v.diffs)
+				}
+			}
+		})
+	}
+}
@@ -403,6 +403,7 @@ func (m *Mark) BuildResourceDoc() *model.ResourceDoc {
 	}
 
 	doc.ResourceName = m.ResourceType
+	doc.Examples = m.exampleBlocks()
 	for _, item := range m.Items {
 		switch item.Type {
 		case ItemExample:
@@ -414,3 +415,45 @@ func (m *Mark) BuildResourceDoc() *model.ResourceDoc {
 
 	return doc
 }
+
+// exampleBlocks returns the HCL code blocks within the `## Example Usage` sections, these are read from the raw
+// content since lines within a code block (e.g. `# comment`) can be mistaken for other items
+func (m *Mark) exampleBlocks() (res []model.Example) {
+	if m.content == nil {
+		return nil
+	}
+
+	var inExample, inCode bool
+	var current *model.Example
+	var lines []string
+	for idx, line := range strings.Split(*m.content, "\n") {
+		if !inCode && strings.HasPrefix(line, "## ") {
+			inExample = posRegs[model.PosExample].MatchString(line)
+			continue
+		}
+
+		if !strings.HasPrefix(strings.TrimSpace(line), "```") {
+			if current != nil {
+				lines = append(lines, line)
+			}
+			continue
+		}
+
+		if inCode {
+			if current != nil {
+				current.HCL = strings.Join(lines, "\n")
+				res = append(res, *current)
+			}
+			inCode, current, lines = false, nil, nil
+			continue
+		}
+
+		inCode = true
+		lang := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "```"))
+		if inExample && (lang == "hcl" || lang == "terraform") {
+			current = &model.Example{Line: idx + 1}
+		}
+	}
+
+	return res
+}
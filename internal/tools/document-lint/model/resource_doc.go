@@ -219,6 +219,12 @@ func (p Properties) FindAllSubBlock(name string) (res []*Field) {
 	return res
 }
 
+// Example is a HCL code block within the `## Example Usage` section of the document
+type Example struct {
+	Line int // line number of the first line of HCL, after the opening code fence
+	HCL  string
+}
+
 type PossibleValue struct {
 	Valeus []string
 	Field  *Field
@@ -236,6 +242,7 @@ type ResourceDoc struct {
 	Args         Properties
 	Attr         Properties
 	ExampleHCL   string
+	Examples     []Example
 	Timeouts     *Timeouts // nil if no timeouts part in document
 	Import       Import
 
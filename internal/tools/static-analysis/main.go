@@ -10,6 +10,7 @@
 //
 //	go run internal/tools/static-analysis/main.go                           # run all rules
 //	go run internal/tools/static-analysis/main.go -rules=combinedIfErr     # run a specific rule
+//	go run internal/tools/static-analysis/main.go -rules=requiresImport    # run an opt-in rule, these aren't included in `all`
 //	go run internal/tools/static-analysis/main.go -fail-on-error=false     # log errors without failing
 
 package main
@@ -25,8 +26,19 @@ import (
 )
 
 var allRules = map[string]rules.Rule{
-	rules.TypedSDKBitCheck{}.Name():   rules.TypedSDKBitCheck{},
-	rules.CombinedIfErrCheck{}.Name(): rules.CombinedIfErrCheck{},
+	rules.TypedSDKBitCheck{}.Name():        rules.TypedSDKBitCheck{},
+	rules.CombinedIfErrCheck{}.Name():      rules.CombinedIfErrCheck{},
+	rules.ReadNotFoundCheck{}.Name():       rules.ReadNotFoundCheck{},
+	rules.TimeoutsDocumentedCheck{}.Name(): rules.TimeoutsDocumentedCheck{},
+	rules.WriteOnlySiblingCheck{}.Name():   rules.WriteOnlySiblingCheck{},
+}
+
+// optInRules are only run when explicitly specified, since the codebase contains existing violations
+// of these which need to be resolved before they can be enforced as a part of `all`
+var optInRules = map[string]rules.Rule{
+	rules.IgnoredSetErrorCheck{}.Name():       rules.IgnoredSetErrorCheck{},
+	rules.NilPointerDereferenceCheck{}.Name(): rules.NilPointerDereferenceCheck{},
+	rules.RequiresImportCheck{}.Name():        rules.RequiresImportCheck{},
 }
 
 func main() {
@@ -60,6 +72,10 @@ func main() {
 		if r, ok := allRules[rule]; ok {
 			errors = append(errors, r.Run()...)
 		}
+
+		if r, ok := optInRules[rule]; ok {
+			errors = append(errors, r.Run()...)
+		}
 	}
 
 	if len(errors) > 0 {
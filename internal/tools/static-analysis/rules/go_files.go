@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// serviceFile is a parsed (non-test) Go file within internal/services
+type serviceFile struct {
+	path string
+	fset *token.FileSet
+	file *ast.File
+}
+
+func (f serviceFile) position(pos token.Pos) string {
+	return fmt.Sprintf("%s:%d", f.path, f.fset.Position(pos).Line)
+}
+
+// walkServiceFiles parses each non-test Go file within internal/services and invokes fn for it
+func walkServiceFiles(fn func(f serviceFile) []error) (errors []error) {
+	fset := token.NewFileSet()
+	err := filepath.Walk("internal/services", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("parsing %s: %+v", path, err))
+			return nil
+		}
+
+		errors = append(errors, fn(serviceFile{path: path, fset: fset, file: file})...)
+		return nil
+	})
+	if err != nil {
+		errors = append(errors, fmt.Errorf("walking internal/services: %+v", err))
+	}
+
+	return
+}
+
+// callName returns the name of the function being called, e.g. `Set` for `d.Set(...)`
+func callName(call *ast.CallExpr) string {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		return fun.Name
+	case *ast.SelectorExpr:
+		return fun.Sel.Name
+	}
+
+	return ""
+}
+
+// containsCall returns whether the node contains a call to a function with one of the specified names
+func containsCall(node ast.Node, names ...string) (found bool) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if call, ok := n.(*ast.CallExpr); ok {
+			name := callName(call)
+			for _, v := range names {
+				if name == v {
+					found = true
+					return false
+				}
+			}
+		}
+		return true
+	})
+
+	return
+}
+
+// isResourceFuncMethod returns whether the declaration is a method with the specified name returning an `sdk.ResourceFunc`
+func isResourceFuncMethod(decl *ast.FuncDecl, name string) bool {
+	if decl.Recv == nil || decl.Name.Name != name || decl.Body == nil {
+		return false
+	}
+	if decl.Type.Results == nil || len(decl.Type.Results.List) != 1 {
+		return false
+	}
+
+	sel, ok := decl.Type.Results.List[0].Type.(*ast.SelectorExpr)
+	return ok && sel.Sel.Name == "ResourceFunc"
+}
@@ -0,0 +1,23 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+// parseFixture parses the source of a Go file within the `example` package, as if it were at the specified path
+func parseFixture(t *testing.T, path, src string) serviceFile {
+	t.Helper()
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, "package example\n\n"+src, parser.SkipObjectResolution)
+	if err != nil {
+		t.Fatalf("parsing fixture: %+v", err)
+	}
+
+	return serviceFile{path: path, fset: fset, file: file}
+}
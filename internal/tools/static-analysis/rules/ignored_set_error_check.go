@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var _ Rule = IgnoredSetErrorCheck{}
+
+type IgnoredSetErrorCheck struct{}
+
+func (r IgnoredSetErrorCheck) Run() (errors []error) {
+	// functions returning nested blocks are tracked per package, since flatten functions live alongside the resources
+	nestedFuncsByDir := make(map[string]map[string]struct{})
+
+	return walkServiceFiles(func(f serviceFile) (errors []error) {
+		dir := filepath.Dir(f.path)
+		nestedFuncs, ok := nestedFuncsByDir[dir]
+		if !ok {
+			nestedFuncs = findNestedBlockFuncs(dir)
+			nestedFuncsByDir[dir] = nestedFuncs
+		}
+
+		return checkIgnoredSetError(f, nestedFuncs)
+	})
+}
+
+// checkIgnoredSetError checks the file for nested blocks being set without checking the error, where nestedFuncs are
+// the names of the functions within the package which return a nested block
+func checkIgnoredSetError(f serviceFile, nestedFuncs map[string]struct{}) (errors []error) {
+	ast.Inspect(f.file, func(n ast.Node) bool {
+		stmt, ok := n.(*ast.ExprStmt)
+		if !ok {
+			return true
+		}
+		call, ok := stmt.X.(*ast.CallExpr)
+		if !ok || !isResourceDataSet(call) {
+			return true
+		}
+
+		if isNestedBlockValue(call.Args[1], nestedFuncs) {
+			key := call.Args[0].(*ast.BasicLit).Value
+			errors = append(errors, fmt.Errorf("%s: the error returned from setting the nested block %s should be checked", f.position(call.Pos()), key))
+		}
+
+		return true
+	})
+
+	return
+}
+
+// isResourceDataSet returns whether the call is `d.Set("key", value)` or `metadata.ResourceData.Set("key", value)`
+func isResourceDataSet(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Set" || len(call.Args) != 2 {
+		return false
+	}
+
+	switch x := sel.X.(type) {
+	case *ast.Ident:
+		if x.Name != "d" {
+			return false
+		}
+	case *ast.SelectorExpr:
+		if x.Sel.Name != "ResourceData" {
+			return false
+		}
+	default:
+		return false
+	}
+
+	lit, ok := call.Args[0].(*ast.BasicLit)
+	return ok && lit.Kind == token.STRING
+}
+
+// isNestedBlockValue returns whether the expression is a list/map literal or a call to a function returning one
+func isNestedBlockValue(expr ast.Expr, nestedFuncs map[string]struct{}) bool {
+	switch v := expr.(type) {
+	case *ast.CompositeLit:
+		switch v.Type.(type) {
+		case *ast.ArrayType, *ast.MapType:
+			return true
+		}
+	case *ast.CallExpr:
+		if ident, ok := v.Fun.(*ast.Ident); ok {
+			_, isNested := nestedFuncs[ident.Name]
+			return isNested
+		}
+	}
+
+	return false
+}
+
+// findNestedBlockFuncs returns the names of the functions within the package which return a list or map as their first result
+func findNestedBlockFuncs(dir string) map[string]struct{} {
+	result := make(map[string]struct{})
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return result
+	}
+
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			continue
+		}
+
+		addNestedBlockFuncs(file, result)
+	}
+
+	return result
+}
+
+// addNestedBlockFuncs adds the names of the functions within the file which return a list or map as their first result
+func addNestedBlockFuncs(file *ast.File, result map[string]struct{}) {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Type.Results == nil || len(fn.Type.Results.List) == 0 {
+			continue
+		}
+
+		switch fn.Type.Results.List[0].Type.(type) {
+		case *ast.ArrayType, *ast.MapType:
+			result[fn.Name.Name] = struct{}{}
+		}
+	}
+}
+
+func (r IgnoredSetErrorCheck) Name() string {
+	return "ignoredSetError"
+}
+
+func (r IgnoredSetErrorCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check ensures that the error returned from 'd.Set' is checked when setting a nested
+block (a list or map, either as a literal or returned from a flatten function), since a mismatch
+between the flattened value and the schema is otherwise silently dropped:
+
+	if err := d.Set("example", flattenExample(props.Example)); err != nil {
+		return fmt.Errorf("setting `+"`example`"+`: %%+v", err)
+	}
+`, r.Name())
+}
@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import "testing"
+
+func TestIgnoredSetErrorCheck(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Source   string
+		Expected int
+	}{
+		{
+			Name: "nested block from a flatten function with the error checked",
+			Source: `
+func resourceExampleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	if err := d.Set("block", flattenBlock(props.Block)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func flattenBlock(input *Block) []interface{} {
+	return nil
+}`,
+			Expected: 0,
+		},
+		{
+			Name: "nested block from a flatten function with the error ignored",
+			Source: `
+func resourceExampleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	d.Set("block", flattenBlock(props.Block))
+	return nil
+}
+
+func flattenBlock(input *Block) []interface{} {
+	return nil
+}`,
+			Expected: 1,
+		},
+		{
+			Name: "nested block literal with the error ignored",
+			Source: `
+func (r ExampleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			metadata.ResourceData.Set("tags", map[string]interface{}{})
+			return nil
+		},
+	}
+}`,
+			Expected: 1,
+		},
+		{
+			Name: "top-level value with the error ignored",
+			Source: `
+func resourceExampleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	d.Set("name", id.Name)
+	d.Set("location", location.Normalize(model.Location))
+	return nil
+}`,
+			Expected: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			f := parseFixture(t, "internal/services/example/example_resource.go", tc.Source)
+
+			nestedFuncs := make(map[string]struct{})
+			addNestedBlockFuncs(f.file, nestedFuncs)
+
+			errors := checkIgnoredSetError(f, nestedFuncs)
+			if len(errors) != tc.Expected {
+				t.Fatalf("expected %d error(s) but got %d: %+v", tc.Expected, len(errors), errors)
+			}
+		})
+	}
+}
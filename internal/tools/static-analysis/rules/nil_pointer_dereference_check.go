@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path"
+	"strconv"
+)
+
+var _ Rule = NilPointerDereferenceCheck{}
+
+type NilPointerDereferenceCheck struct{}
+
+func (r NilPointerDereferenceCheck) Run() []error {
+	return walkServiceFiles(checkNilPointerDereference)
+}
+
+func checkNilPointerDereference(f serviceFile) (errors []error) {
+	imports := importNames(f.file)
+
+	for _, decl := range f.file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		nilChecked := nilCheckedExpressions(fn.Body)
+		assigned := assignedDereferences(fn.Body)
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			star, ok := n.(*ast.StarExpr)
+			if !ok {
+				return true
+			}
+
+			sel, ok := star.X.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+
+			// `*pkg.Type` is a pointer type rather than a dereference
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				if _, isImport := imports[ident.Name]; isImport {
+					return true
+				}
+			}
+
+			if _, ok := assigned[star]; ok {
+				return true
+			}
+
+			expr := types.ExprString(sel)
+			if _, ok := nilChecked[expr]; !ok {
+				errors = append(errors, fmt.Errorf("%s: `%s` is dereferenced without being checked for nil, consider using `pointer.From`", f.position(star.Pos()), expr))
+			}
+
+			return true
+		})
+	}
+
+	return
+}
+
+// importNames returns the names which the imports within the file are referenced by
+func importNames(file *ast.File) map[string]struct{} {
+	result := make(map[string]struct{})
+
+	for _, imp := range file.Imports {
+		if imp.Name != nil {
+			result[imp.Name.Name] = struct{}{}
+			continue
+		}
+
+		if v, err := strconv.Unquote(imp.Path.Value); err == nil {
+			result[path.Base(v)] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// nilCheckedExpressions returns the expressions compared against nil within the node, e.g. `props.Name` for `props.Name != nil`
+func nilCheckedExpressions(node ast.Node) map[string]struct{} {
+	result := make(map[string]struct{})
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		binary, ok := n.(*ast.BinaryExpr)
+		if !ok || (binary.Op != token.NEQ && binary.Op != token.EQL) {
+			return true
+		}
+
+		if isNil(binary.Y) {
+			result[types.ExprString(binary.X)] = struct{}{}
+		}
+		if isNil(binary.X) {
+			result[types.ExprString(binary.Y)] = struct{}{}
+		}
+
+		return true
+	})
+
+	return result
+}
+
+// assignedDereferences returns the dereferences which are being assigned to, e.g. `*props.Name = "example"`
+func assignedDereferences(node ast.Node) map[*ast.StarExpr]struct{} {
+	result := make(map[*ast.StarExpr]struct{})
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		if assign, ok := n.(*ast.AssignStmt); ok {
+			for _, lhs := range assign.Lhs {
+				if star, ok := lhs.(*ast.StarExpr); ok {
+					result[star] = struct{}{}
+				}
+			}
+		}
+		return true
+	})
+
+	return result
+}
+
+func isNil(expr ast.Expr) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == "nil"
+}
+
+func (r NilPointerDereferenceCheck) Name() string {
+	return "nilPointerDereference"
+}
+
+func (r NilPointerDereferenceCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check ensures that pointer fields (e.g. within SDK models) aren't dereferenced without
+first being checked for nil within the same function, since the API can omit these at any time.
+Prefer 'pointer.From(props.Example)', or guard the dereference with 'if props.Example != nil'.
+`, r.Name())
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import "testing"
+
+func TestNilPointerDereferenceCheck(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Source   string
+		Expected int
+	}{
+		{
+			Name: "dereference guarded by a nil check",
+			Source: `
+func flattenExample(props *Properties) string {
+	if props.Name != nil {
+		return *props.Name
+	}
+	return ""
+}`,
+			Expected: 0,
+		},
+		{
+			Name: "dereference without a nil check",
+			Source: `
+func flattenExample(props *Properties) string {
+	return *props.Name
+}`,
+			Expected: 1,
+		},
+		{
+			Name: "pointer type from an imported package",
+			Source: `
+import "example.com/sdk/models"
+
+func expandExample() *models.Properties {
+	var result *models.Properties
+	return result
+}`,
+			Expected: 0,
+		},
+		{
+			Name: "assignment through a pointer",
+			Source: `
+func expandExample(props *Properties) {
+	*props.Name = "example"
+}`,
+			Expected: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			errors := checkNilPointerDereference(parseFixture(t, "internal/services/example/example_resource.go", tc.Source))
+			if len(errors) != tc.Expected {
+				t.Fatalf("expected %d error(s) but got %d: %+v", tc.Expected, len(errors), errors)
+			}
+		})
+	}
+}
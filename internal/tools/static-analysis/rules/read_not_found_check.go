@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var _ Rule = ReadNotFoundCheck{}
+
+var matchUntypedReadFunc = regexp.MustCompile(`^resource\w+Read$`)
+
+// readNotFoundExemptions are the files containing Read functions which determine that the resource doesn't exist in a
+// way this check can't detect, along with the reason why
+var readNotFoundExemptions = map[string]string{
+	"internal/services/compute/managed_disk_sas_token_resource.go":           "the SAS is gone once the disk is no longer in the `ActiveSAS` state",
+	"internal/services/storage/storage_account_queue_properties_resource.go": "`GetAccount` doesn't expose the HTTP response, so any error is treated as the account being gone",
+	"internal/services/storage/storage_account_static_website_resource.go":   "`GetAccount` doesn't expose the HTTP response, so any error is treated as the account being gone",
+	"internal/services/storage/storage_account_table_properties_resource.go": "`GetAccount` doesn't expose the HTTP response, so any error is treated as the account being gone",
+	"internal/services/web/app_service_public_certificate_resource.go":       "the certificate is polled until it exists, and the resulting timeout is matched on its error message",
+}
+
+type ReadNotFoundCheck struct{}
+
+func (r ReadNotFoundCheck) Run() []error {
+	return walkServiceFiles(checkReadNotFound)
+}
+
+func checkReadNotFound(f serviceFile) (errors []error) {
+	// Data Sources are expected to return an error when the resource isn't found
+	if strings.HasSuffix(f.path, "_data_source.go") {
+		return nil
+	}
+	if _, ok := readNotFoundExemptions[filepath.ToSlash(f.path)]; ok {
+		return nil
+	}
+
+	for _, decl := range f.file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		// Read functions which delegate to a shared implementation are checked there instead, and those
+		// which don't retrieve anything from the API have nothing to handle
+		if isDelegatingFunc(fn) || !containsApiRetrieval(fn.Body) {
+			continue
+		}
+
+		switch {
+		case isResourceFuncMethod(fn, "Read") && !strings.HasSuffix(receiverName(fn), "DataSource"):
+			if !containsNotFoundRemoval(fn.Body) {
+				errors = append(errors, fmt.Errorf("%s: the Read function for %s doesn't remove the resource from the state using `metadata.MarkAsGone` when it's not found", f.position(fn.Pos()), receiverName(fn)))
+			}
+
+		case fn.Recv == nil && matchUntypedReadFunc.MatchString(fn.Name.Name):
+			if !containsNotFoundRemoval(fn.Body) {
+				errors = append(errors, fmt.Errorf("%s: %s doesn't remove the resource from the state using `d.SetId(\"\")` when it's not found", f.position(fn.Pos()), fn.Name.Name))
+			}
+		}
+	}
+
+	return
+}
+
+// isDelegatingFunc returns whether the function body only returns the result of calling another function
+func isDelegatingFunc(fn *ast.FuncDecl) bool {
+	if len(fn.Body.List) != 1 {
+		return false
+	}
+
+	ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return false
+	}
+
+	_, ok = ret.Results[0].(*ast.CallExpr)
+	return ok
+}
+
+// containsApiRetrieval returns whether the node contains a call to an API client retrieving a resource, e.g.
+// `client.Get(ctx, id)` - calls such as `d.Get("name")` don't take a context so aren't matched
+func containsApiRetrieval(node ast.Node) (found bool) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		if _, ok := call.Fun.(*ast.SelectorExpr); !ok || !strings.HasPrefix(callName(call), "Get") {
+			return true
+		}
+		if ident, ok := call.Args[0].(*ast.Ident); ok && ident.Name == "ctx" {
+			found = true
+			return false
+		}
+		return true
+	})
+
+	return
+}
+
+// containsNotFoundRemoval returns whether the node contains a branch conditional on the resource not being found which
+// removes the resource from the state, e.g. `if response.WasNotFound(resp.HttpResponse)` or `if model == nil`
+func containsNotFoundRemoval(node ast.Node) (found bool) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		stmt, ok := n.(*ast.IfStmt)
+		if !ok || !isNotFoundCondition(stmt.Cond) {
+			return true
+		}
+		if containsCall(stmt.Body, "MarkAsGone") || containsEmptySetId(stmt.Body) {
+			found = true
+			return false
+		}
+		return true
+	})
+
+	return
+}
+
+// isNotFoundCondition returns whether the condition checks that the resource wasn't found - either using a NotFound
+// check such as `response.WasNotFound` or `http.StatusNotFound`, or by checking that the retrieved value is nil, empty
+// or doesn't exist. Negated NotFound checks (e.g. `!response.WasNotFound(...)`) are the inverse of this and don't match.
+func isNotFoundCondition(cond ast.Expr) (found bool) {
+	negated := false
+	ast.Inspect(cond, func(n ast.Node) bool {
+		if unary, ok := n.(*ast.UnaryExpr); ok && unary.Op == token.NOT && referencesNotFound(unary.X) {
+			negated = true
+			return false
+		}
+		return true
+	})
+	if negated {
+		return false
+	}
+
+	ast.Inspect(cond, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		switch v := n.(type) {
+		case *ast.Ident:
+			found = strings.Contains(v.Name, "NotFound")
+
+		case *ast.UnaryExpr:
+			// e.g. `!exists` or `!pointer.From(exists)`
+			found = v.Op == token.NOT
+
+		case *ast.BinaryExpr:
+			// e.g. `model == nil` or `len(items) == 0`
+			if v.Op == token.EQL {
+				found = isNil(v.X) || isNil(v.Y) || isLenZero(v.X, v.Y) || isLenZero(v.Y, v.X)
+			}
+		}
+
+		return !found
+	})
+
+	return
+}
+
+// referencesNotFound returns whether the expression references a NotFound check, such as `response.WasNotFound`
+func referencesNotFound(expr ast.Expr) (found bool) {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && strings.Contains(ident.Name, "NotFound") {
+			found = true
+		}
+		return !found
+	})
+
+	return
+}
+
+// isLenZero returns whether the expressions are a call to `len` and the literal `0`
+func isLenZero(x, y ast.Expr) bool {
+	call, ok := x.(*ast.CallExpr)
+	if !ok || callName(call) != "len" {
+		return false
+	}
+
+	lit, ok := y.(*ast.BasicLit)
+	return ok && lit.Value == "0"
+}
+
+// containsEmptySetId returns whether the node contains a call to `d.SetId("")`
+func containsEmptySetId(node ast.Node) (found bool) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if call, ok := n.(*ast.CallExpr); ok && callName(call) == "SetId" && len(call.Args) == 1 {
+			if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Value == `""` {
+				found = true
+				return false
+			}
+		}
+		return true
+	})
+
+	return
+}
+
+func (r ReadNotFoundCheck) Name() string {
+	return "readNotFound"
+}
+
+func (r ReadNotFoundCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check ensures that Read functions remove the resource from the state when the API
+returns a 404 - using 'metadata.MarkAsGone' for Typed Resources and 'd.SetId("")' for Untyped
+Resources - rather than returning an error, so that resources deleted out-of-band are recreated.
+`, r.Name())
+}
@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import "testing"
+
+func TestReadNotFoundCheck(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Path     string
+		Source   string
+		Expected int
+	}{
+		{
+			Name: "untyped resource removed on 404",
+			Path: "internal/services/example/example_resource.go",
+			Source: `
+func resourceExampleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}`,
+			Expected: 0,
+		},
+		{
+			Name: "untyped resource returning an error on 404",
+			Path: "internal/services/example/example_resource.go",
+			Source: `
+func resourceExampleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		return err
+	}
+	return nil
+}`,
+			Expected: 1,
+		},
+		{
+			Name: "untyped resource removed when the 404 check is negated",
+			Path: "internal/services/example/example_resource.go",
+			Source: `
+func resourceExampleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	return nil
+}`,
+			Expected: 1,
+		},
+		{
+			Name: "untyped resource removed outside of the 404 branch",
+			Path: "internal/services/example/example_resource.go",
+			Source: `
+func resourceExampleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		return err
+	}
+	if d.Get("enabled").(bool) {
+		d.SetId("")
+	}
+	return nil
+}`,
+			Expected: 1,
+		},
+		{
+			Name: "untyped resource only reading from the config",
+			Path: "internal/services/example/example_resource.go",
+			Source: `
+func resourceExampleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	d.Set("display_name", name)
+	return nil
+}`,
+			Expected: 0,
+		},
+		{
+			Name: "typed resource marked as gone on 404",
+			Path: "internal/services/example/example_resource.go",
+			Source: `
+func (r ExampleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return err
+			}
+			return nil
+		},
+	}
+}`,
+			Expected: 0,
+		},
+		{
+			Name: "typed resource marked as gone when the item isn't present",
+			Path: "internal/services/example/example_resource.go",
+			Source: `
+func (r ExampleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			items, err := client.GetItems(ctx, *id)
+			if err != nil {
+				return err
+			}
+			if len(items) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+			return nil
+		},
+	}
+}`,
+			Expected: 0,
+		},
+		{
+			Name: "typed resource returning an error on 404",
+			Path: "internal/services/example/example_resource.go",
+			Source: `
+func (r ExampleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return err
+			}
+			return metadata.Encode(&state)
+		},
+	}
+}`,
+			Expected: 1,
+		},
+		{
+			Name: "data source returning an error on 404",
+			Path: "internal/services/example/example_data_source.go",
+			Source: `
+func dataSourceExampleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		return err
+	}
+	return nil
+}`,
+			Expected: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			errors := checkReadNotFound(parseFixture(t, tc.Path, tc.Source))
+			if len(errors) != tc.Expected {
+				t.Fatalf("expected %d error(s) but got %d: %+v", tc.Expected, len(errors), errors)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"regexp"
+)
+
+var _ Rule = RequiresImportCheck{}
+
+var matchUntypedCreateFunc = regexp.MustCompile(`^resource\w+Create(Update)?$`)
+
+type RequiresImportCheck struct{}
+
+func (r RequiresImportCheck) Run() []error {
+	return walkServiceFiles(checkRequiresImport)
+}
+
+func checkRequiresImport(f serviceFile) (errors []error) {
+	for _, decl := range f.file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		switch {
+		case isResourceFuncMethod(fn, "Create"):
+			if !containsCall(fn.Body, "ResourceRequiresImport", "ImportAsExistsError") {
+				errors = append(errors, fmt.Errorf("%s: the Create function for %s doesn't check for an existing resource using `metadata.ResourceRequiresImport`", f.position(fn.Pos()), receiverName(fn)))
+			}
+
+		case fn.Recv == nil && matchUntypedCreateFunc.MatchString(fn.Name.Name):
+			// Create functions set the ID of the new resource, this filters out helpers which happen to match the name
+			if !containsSetId(fn.Body) {
+				continue
+			}
+
+			if !containsCall(fn.Body, "ImportAsExistsError") {
+				errors = append(errors, fmt.Errorf("%s: %s doesn't check for an existing resource using `tf.ImportAsExistsError`", f.position(fn.Pos()), fn.Name.Name))
+			}
+		}
+	}
+
+	return
+}
+
+// containsSetId returns whether the node contains a call to `d.SetId` with a non-empty value
+func containsSetId(node ast.Node) (found bool) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if call, ok := n.(*ast.CallExpr); ok && callName(call) == "SetId" && len(call.Args) == 1 {
+			if lit, ok := call.Args[0].(*ast.BasicLit); !ok || lit.Value != `""` {
+				found = true
+				return false
+			}
+		}
+		return true
+	})
+
+	return
+}
+
+// receiverName returns the type name of the receiver for the method, e.g. `ExampleResource`
+func receiverName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return ""
+	}
+
+	switch t := fn.Recv.List[0].Type.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		if ident, ok := t.X.(*ast.Ident); ok {
+			return ident.Name
+		}
+	}
+
+	return ""
+}
+
+func (r RequiresImportCheck) Name() string {
+	return "requiresImport"
+}
+
+func (r RequiresImportCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check ensures that Create functions check whether the resource already exists prior to
+creating it - using 'metadata.ResourceRequiresImport' for Typed Resources and 'tf.ImportAsExistsError'
+for Untyped Resources - so that existing resources are imported rather than silently taken over.
+`, r.Name())
+}
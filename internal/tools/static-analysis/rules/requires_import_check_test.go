@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import "testing"
+
+func TestRequiresImportCheck(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Source   string
+		Expected int
+	}{
+		{
+			Name: "untyped resource checking for an existing resource",
+			Source: `
+func resourceExampleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	existing, err := client.Get(ctx, id)
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_example", id.ID())
+	}
+	d.SetId(id.ID())
+	return nil
+}`,
+			Expected: 0,
+		},
+		{
+			Name: "untyped resource not checking for an existing resource",
+			Source: `
+func resourceExampleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	d.SetId(id.ID())
+	return nil
+}`,
+			Expected: 1,
+		},
+		{
+			Name: "untyped helper which doesn't set the ID",
+			Source: `
+func resourceExampleCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	return nil
+}`,
+			Expected: 0,
+		},
+		{
+			Name: "typed resource checking for an existing resource",
+			Source: `
+func (r ExampleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+			return nil
+		},
+	}
+}`,
+			Expected: 0,
+		},
+		{
+			Name: "typed resource not checking for an existing resource",
+			Source: `
+func (r ExampleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}`,
+			Expected: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			errors := checkRequiresImport(parseFixture(t, "internal/services/example/example_resource.go", tc.Source))
+			if len(errors) != tc.Expected {
+				t.Fatalf("expected %d error(s) but got %d: %+v", tc.Expected, len(errors), errors)
+			}
+		})
+	}
+}
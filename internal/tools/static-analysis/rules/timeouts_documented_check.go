@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ Rule = TimeoutsDocumentedCheck{}
+
+type TimeoutsDocumentedCheck struct{}
+
+func (r TimeoutsDocumentedCheck) Run() (errors []error) {
+	resources := provider.AzureProvider().ResourcesMap
+
+	// intentionally sorting these so the output is consistent
+	resourceNames := make([]string, 0, len(resources))
+	for name := range resources {
+		resourceNames = append(resourceNames, name)
+	}
+	sort.Strings(resourceNames)
+
+	for _, name := range resourceNames {
+		timeouts := resources[name].Timeouts
+		if timeouts == nil {
+			continue
+		}
+
+		docPath := filepath.Join("website", "docs", "r", fmt.Sprintf("%s.html.markdown", strings.TrimPrefix(name, "azurerm_")))
+		contents, err := os.ReadFile(docPath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				// documentation for deprecated resources may have been removed, which is covered by the website tests
+				continue
+			}
+			errors = append(errors, fmt.Errorf("reading %s: %+v", docPath, err))
+			continue
+		}
+
+		errors = append(errors, checkTimeoutsDocumented(docPath, name, timeouts, string(contents))...)
+	}
+
+	return
+}
+
+// checkTimeoutsDocumented checks that each of the Timeouts defined for the Resource are listed in its documentation
+func checkTimeoutsDocumented(docPath, name string, timeouts *pluginsdk.ResourceTimeout, contents string) (errors []error) {
+	section := timeoutsSection(contents)
+	if section == "" {
+		return []error{fmt.Errorf("%s: %s defines Timeouts but the documentation has no `## Timeouts` section", docPath, name)}
+	}
+
+	expected := map[string]bool{
+		"create": timeouts.Create != nil,
+		"read":   timeouts.Read != nil,
+		"update": timeouts.Update != nil,
+		"delete": timeouts.Delete != nil,
+	}
+	for _, operation := range []string{"create", "read", "update", "delete"} {
+		if expected[operation] && !strings.Contains(section, fmt.Sprintf("* `%s` -", operation)) {
+			errors = append(errors, fmt.Errorf("%s: the `%s` Timeout for %s is missing from the documentation", docPath, operation, name))
+		}
+	}
+
+	return
+}
+
+// timeoutsSection returns the contents of the `## Timeouts` section within the documentation, if present
+func timeoutsSection(contents string) string {
+	_, section, found := strings.Cut(contents, "\n## Timeouts")
+	if !found {
+		return ""
+	}
+
+	if idx := strings.Index(section, "\n## "); idx != -1 {
+		section = section[:idx]
+	}
+
+	return section
+}
+
+func (r TimeoutsDocumentedCheck) Name() string {
+	return "timeoutsDocumented"
+}
+
+func (r TimeoutsDocumentedCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check ensures that each Timeout defined for a Resource is listed within the
+'## Timeouts' section of the corresponding documentation page in 'website/docs/r'.
+`, r.Name())
+}
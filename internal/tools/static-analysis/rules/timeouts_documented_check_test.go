@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestTimeoutsDocumentedCheck(t *testing.T) {
+	timeouts := &pluginsdk.ResourceTimeout{
+		Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+		Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+		Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+	}
+
+	cases := []struct {
+		Name     string
+		Contents string
+		Expected int
+	}{
+		{
+			Name: "all timeouts documented",
+			Contents: `
+## Attributes Reference
+
+* ` + "`id`" + ` - The ID of the Example.
+
+## Timeouts
+
+* ` + "`create`" + ` - (Defaults to 30 minutes) Used when creating the Example.
+* ` + "`read`" + ` - (Defaults to 5 minutes) Used when retrieving the Example.
+* ` + "`delete`" + ` - (Defaults to 30 minutes) Used when deleting the Example.
+
+## Import
+`,
+			Expected: 0,
+		},
+		{
+			Name: "timeout missing from the section",
+			Contents: `
+## Timeouts
+
+* ` + "`create`" + ` - (Defaults to 30 minutes) Used when creating the Example.
+* ` + "`delete`" + ` - (Defaults to 30 minutes) Used when deleting the Example.
+
+## Import
+
+* ` + "`read`" + ` - this isn't within the Timeouts section.
+`,
+			Expected: 1,
+		},
+		{
+			Name: "timeouts section using the wrong heading",
+			Contents: `
+### Timeouts
+
+* ` + "`create`" + ` - (Defaults to 30 minutes) Used when creating the Example.
+`,
+			Expected: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			errors := checkTimeoutsDocumented("example.html.markdown", "azurerm_example", timeouts, tc.Contents)
+			if len(errors) != tc.Expected {
+				t.Fatalf("expected %d error(s) but got %d: %+v", tc.Expected, len(errors), errors)
+			}
+		})
+	}
+}
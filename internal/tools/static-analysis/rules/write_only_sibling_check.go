@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ Rule = WriteOnlySiblingCheck{}
+
+type WriteOnlySiblingCheck struct{}
+
+func (r WriteOnlySiblingCheck) Run() (errors []error) {
+	resources := provider.AzureProvider().ResourcesMap
+
+	// intentionally sorting these so the output is consistent
+	resourceNames := make([]string, 0, len(resources))
+	for name := range resources {
+		resourceNames = append(resourceNames, name)
+	}
+	sort.Strings(resourceNames)
+
+	for _, name := range resourceNames {
+		found := make(map[string]struct{})
+		for _, argument := range sensitiveArgumentsWithoutWriteOnlySibling("", resources[name].Schema) {
+			found[argument] = struct{}{}
+			if slices.Contains(writeOnlySiblingExemptions[name], argument) {
+				continue
+			}
+
+			errors = append(errors, fmt.Errorf("%s: sensitive argument `%s` has no write-only sibling `%s_wo`", name, argument, lastSegment(argument)))
+		}
+
+		// exemptions are removed once they're no longer needed, so that the list only ever shrinks
+		for _, argument := range writeOnlySiblingExemptions[name] {
+			if _, ok := found[argument]; !ok {
+				errors = append(errors, fmt.Errorf("%s: the exemption for `%s` is no longer needed and should be removed from `writeOnlySiblingExemptions`", name, argument))
+			}
+		}
+	}
+
+	exemptedNames := make([]string, 0, len(writeOnlySiblingExemptions))
+	for name := range writeOnlySiblingExemptions {
+		exemptedNames = append(exemptedNames, name)
+	}
+	sort.Strings(exemptedNames)
+
+	for _, name := range exemptedNames {
+		if _, ok := resources[name]; !ok {
+			errors = append(errors, fmt.Errorf("%s: the resource no longer exists and should be removed from `writeOnlySiblingExemptions`", name))
+		}
+	}
+
+	return
+}
+
+// sensitiveArgumentsWithoutWriteOnlySibling returns the paths of the Sensitive string arguments which don't have a
+// Write-Only sibling, skipping those within Sets, Maps and Computed blocks where Write-Only attributes aren't supported
+func sensitiveArgumentsWithoutWriteOnlySibling(path string, input map[string]*pluginsdk.Schema) (result []string) {
+	fieldNames := make([]string, 0, len(input))
+	for fieldName := range input {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+
+	for _, fieldName := range fieldNames {
+		field := input[fieldName]
+
+		switch field.Type {
+		case pluginsdk.TypeString:
+			if !field.Sensitive || field.WriteOnly || !(field.Optional || field.Required) {
+				continue
+			}
+
+			if _, ok := input[fieldName+"_wo"]; !ok {
+				result = append(result, path+fieldName)
+			}
+
+		case pluginsdk.TypeList:
+			// Write-Only attributes are only supported within blocks which aren't Computed
+			if field.Computed {
+				continue
+			}
+
+			if elem, ok := field.Elem.(*pluginsdk.Resource); ok {
+				result = append(result, sensitiveArgumentsWithoutWriteOnlySibling(fmt.Sprintf("%s%s.0.", path, fieldName), elem.Schema)...)
+			}
+
+		default:
+			// Write-Only attributes aren't supported within Sets or Maps
+		}
+	}
+
+	return
+}
+
+func lastSegment(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
+
+func (r WriteOnlySiblingCheck) Name() string {
+	return "writeOnlySibling"
+}
+
+func (r WriteOnlySiblingCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check ensures that each Sensitive string argument within a Resource has a Write-Only
+sibling (suffixed with '_wo', alongside a '_wo_version' trigger) so that the secret can be
+supplied without being persisted into the state. Arguments nested within Sets, Maps or
+Computed blocks are skipped, since Write-Only attributes aren't supported there - for example
+'windows_profile.admin_password' in 'azurerm_kubernetes_cluster' (a Computed block),
+'secret.value' in 'azurerm_container_app' (a Set) and 'app_settings' in the App Service
+resources (a Map). Arguments which are only Computed, such as 'password' in
+'azurerm_storage_account_local_user', are generated by the service and aren't inputs.
+
+Arguments which predate this check are listed in 'writeOnlySiblingExemptions', entries
+should be removed from this list as Write-Only variants are added.
+`, r.Name())
+}
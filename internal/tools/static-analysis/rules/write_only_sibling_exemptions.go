@@ -0,0 +1,886 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+// writeOnlySiblingExemptions are the Sensitive arguments which existed before the `writeOnlySibling` check was enforced
+// and don't yet have a Write-Only sibling. Entries should be removed as Write-Only variants are added, and new Sensitive
+// arguments shouldn't be added here.
+var writeOnlySiblingExemptions = map[string][]string{
+	"azurerm_active_directory_domain_service_trust": {
+		"password",
+	},
+	"azurerm_analysis_services_server": {
+		"backup_blob_container_uri",
+	},
+	"azurerm_api_management": {
+		"certificate.0.certificate_password",
+		"certificate.0.encoded_certificate",
+	},
+	"azurerm_api_management_authorization_server": {
+		"client_secret",
+		"resource_owner_password",
+	},
+	"azurerm_api_management_backend": {
+		"proxy.0.password",
+	},
+	"azurerm_api_management_certificate": {
+		"data",
+		"password",
+	},
+	"azurerm_api_management_custom_domain": {
+		"developer_portal.0.certificate",
+		"developer_portal.0.certificate_password",
+		"gateway.0.certificate",
+		"gateway.0.certificate_password",
+		"management.0.certificate",
+		"management.0.certificate_password",
+		"portal.0.certificate",
+		"portal.0.certificate_password",
+		"scm.0.certificate",
+		"scm.0.certificate_password",
+	},
+	"azurerm_api_management_identity_provider_aad": {
+		"client_secret",
+	},
+	"azurerm_api_management_identity_provider_aadb2c": {
+		"client_secret",
+	},
+	"azurerm_api_management_identity_provider_facebook": {
+		"app_secret",
+	},
+	"azurerm_api_management_identity_provider_google": {
+		"client_secret",
+	},
+	"azurerm_api_management_identity_provider_microsoft": {
+		"client_secret",
+	},
+	"azurerm_api_management_identity_provider_twitter": {
+		"api_key",
+		"api_secret_key",
+	},
+	"azurerm_api_management_logger": {
+		"application_insights.0.connection_string",
+		"application_insights.0.instrumentation_key",
+		"eventhub.0.connection_string",
+	},
+	"azurerm_api_management_openid_connect_provider": {
+		"client_id",
+		"client_secret",
+	},
+	"azurerm_api_management_redis_cache": {
+		"connection_string",
+	},
+	"azurerm_api_management_subscription": {
+		"primary_key",
+		"secondary_key",
+	},
+	"azurerm_api_management_user": {
+		"password",
+	},
+	"azurerm_api_management_workspace_certificate": {
+		"certificate_data_base64",
+		"password",
+	},
+	"azurerm_api_management_workspace_named_value": {
+		"value",
+	},
+	"azurerm_app_service": {
+		"backup.0.storage_account_url",
+	},
+	"azurerm_app_service_certificate": {
+		"password",
+		"pfx_blob",
+	},
+	"azurerm_app_service_connection": {
+		"authentication.0.certificate",
+		"authentication.0.secret",
+	},
+	"azurerm_app_service_source_control": {
+		"github_action_configuration.0.container_configuration.0.registry_password",
+	},
+	"azurerm_app_service_source_control_slot": {
+		"github_action_configuration.0.container_configuration.0.registry_password",
+	},
+	"azurerm_app_service_source_control_token": {
+		"token",
+		"token_secret",
+	},
+	"azurerm_application_gateway": {
+		"authentication_certificate.0.data",
+		"trusted_client_certificate.0.data",
+		"trusted_root_certificate.0.data",
+	},
+	"azurerm_arc_kubernetes_flux_configuration": {
+		"blob_storage.0.account_key",
+		"blob_storage.0.sas_token",
+		"blob_storage.0.service_principal.0.client_certificate_base64",
+		"blob_storage.0.service_principal.0.client_certificate_password",
+		"blob_storage.0.service_principal.0.client_secret",
+		"bucket.0.secret_key_base64",
+		"git_repository.0.https_ca_cert_base64",
+		"git_repository.0.https_key_base64",
+		"git_repository.0.ssh_private_key_base64",
+	},
+	"azurerm_arc_machine_extension": {
+		"protected_settings",
+	},
+	"azurerm_automation_certificate": {
+		"base64",
+	},
+	"azurerm_automation_credential": {
+		"password",
+	},
+	"azurerm_automation_webhook": {
+		"uri",
+	},
+	"azurerm_batch_certificate": {
+		"certificate",
+		"password",
+	},
+	"azurerm_batch_pool": {
+		"container_configuration.0.container_registries.0.password",
+		"extensions.0.protected_settings",
+		"mount.0.azure_blob_file_system.0.account_key",
+		"mount.0.azure_blob_file_system.0.sas_key",
+		"mount.0.azure_file_share.0.account_key",
+		"mount.0.cifs_mount.0.password",
+		"start_task.0.container.0.registry.0.password",
+		"user_accounts.0.linux_user_configuration.0.ssh_private_key",
+		"user_accounts.0.password",
+	},
+	"azurerm_bot_channel_direct_line_speech": {
+		"cognitive_service_access_key",
+	},
+	"azurerm_bot_channel_email": {
+		"email_password",
+		"magic_code",
+	},
+	"azurerm_bot_channel_facebook": {
+		"facebook_application_secret",
+	},
+	"azurerm_bot_channel_slack": {
+		"client_secret",
+		"signing_secret",
+		"verification_token",
+	},
+	"azurerm_bot_channel_sms": {
+		"sms_channel_auth_token",
+	},
+	"azurerm_bot_channels_registration": {
+		"developer_app_insights_api_key",
+	},
+	"azurerm_bot_connection": {
+		"client_secret",
+	},
+	"azurerm_bot_service_azure_bot": {
+		"developer_app_insights_api_key",
+		"luis_key",
+	},
+	"azurerm_bot_web_app": {
+		"developer_app_insights_api_key",
+		"luis_key",
+	},
+	"azurerm_cognitive_account": {
+		"custom_question_answering_search_service_key",
+	},
+	"azurerm_cognitive_account_connection_account_key": {
+		"account_key",
+	},
+	"azurerm_cognitive_account_connection_api_key": {
+		"api_key",
+	},
+	"azurerm_container_app_environment": {
+		"dapr_application_insights_connection_string",
+	},
+	"azurerm_container_app_environment_certificate": {
+		"certificate_password",
+	},
+	"azurerm_container_app_environment_custom_domain": {
+		"certificate_password",
+	},
+	"azurerm_container_app_environment_storage": {
+		"access_key",
+	},
+	"azurerm_container_group": {
+		"container.0.volume.0.storage_account_key",
+		"diagnostics.0.log_analytics.0.workspace_key",
+		"image_registry_credential.0.password",
+		"init_container.0.volume.0.storage_account_key",
+	},
+	"azurerm_container_registry_task": {
+		"base_image_trigger.0.update_trigger_endpoint",
+		"docker_step.0.context_access_token",
+		"encoded_step.0.context_access_token",
+		"file_step.0.context_access_token",
+		"source_trigger.0.authentication.0.refresh_token",
+		"source_trigger.0.authentication.0.token",
+	},
+	"azurerm_cosmosdb_cassandra_cluster": {
+		"default_admin_password",
+	},
+	"azurerm_cosmosdb_mongo_user_definition": {
+		"password",
+	},
+	"azurerm_cosmosdb_postgresql_cluster": {
+		"administrator_login_password",
+	},
+	"azurerm_cosmosdb_postgresql_role": {
+		"password",
+	},
+	"azurerm_dashboard_grafana": {
+		"smtp.0.password",
+	},
+	"azurerm_data_factory_integration_runtime_azure_ssis": {
+		"catalog_info.0.administrator_password",
+		"custom_setup_script.0.sas_token",
+		"express_custom_setup.0.command_key.0.password",
+		"express_custom_setup.0.component.0.license",
+	},
+	"azurerm_data_factory_linked_service_azure_blob_storage": {
+		"connection_string",
+		"sas_uri",
+		"service_endpoint",
+	},
+	"azurerm_data_factory_linked_service_azure_databricks": {
+		"access_token",
+	},
+	"azurerm_data_factory_linked_service_azure_file_storage": {
+		"connection_string",
+		"password",
+	},
+	"azurerm_data_factory_linked_service_azure_function": {
+		"key",
+	},
+	"azurerm_data_factory_linked_service_azure_table_storage": {
+		"connection_string",
+	},
+	"azurerm_data_factory_linked_service_cosmosdb": {
+		"account_key",
+		"connection_string",
+	},
+	"azurerm_data_factory_linked_service_cosmosdb_mongoapi": {
+		"connection_string",
+	},
+	"azurerm_data_factory_linked_service_data_lake_storage_gen2": {
+		"storage_account_key",
+	},
+	"azurerm_data_factory_linked_service_odata": {
+		"basic_authentication.0.password",
+	},
+	"azurerm_data_factory_linked_service_odbc": {
+		"basic_authentication.0.password",
+	},
+	"azurerm_data_factory_linked_service_sftp": {
+		"password",
+		"private_key_content_base64",
+		"private_key_passphrase",
+	},
+	"azurerm_data_factory_linked_service_sql_managed_instance": {
+		"connection_string",
+	},
+	"azurerm_data_factory_linked_service_web": {
+		"password",
+	},
+	"azurerm_datadog_monitor": {
+		"datadog_organization.0.api_key",
+		"datadog_organization.0.application_key",
+		"datadog_organization.0.linking_auth_code",
+		"datadog_organization.0.linking_client_id",
+	},
+	"azurerm_dev_center_network_connection": {
+		"domain_password",
+	},
+	"azurerm_dev_test_linux_virtual_machine": {
+		"password",
+	},
+	"azurerm_dev_test_windows_virtual_machine": {
+		"password",
+	},
+	"azurerm_digital_twins_endpoint_eventhub": {
+		"dead_letter_storage_secret",
+		"eventhub_primary_connection_string",
+		"eventhub_secondary_connection_string",
+	},
+	"azurerm_digital_twins_endpoint_servicebus": {
+		"dead_letter_storage_secret",
+		"servicebus_primary_connection_string",
+		"servicebus_secondary_connection_string",
+	},
+	"azurerm_eventgrid_event_subscription": {
+		"delivery_property.0.value",
+	},
+	"azurerm_eventgrid_system_topic_event_subscription": {
+		"delivery_property.0.value",
+	},
+	"azurerm_express_route_circuit": {
+		"authorization_key",
+	},
+	"azurerm_express_route_circuit_connection": {
+		"authorization_key",
+	},
+	"azurerm_express_route_circuit_peering": {
+		"shared_key",
+	},
+	"azurerm_function_app": {
+		"storage_account_access_key",
+	},
+	"azurerm_function_app_connection": {
+		"authentication.0.certificate",
+		"authentication.0.secret",
+	},
+	"azurerm_function_app_flex_consumption": {
+		"auth_settings.0.active_directory.0.client_secret",
+		"auth_settings.0.facebook.0.app_secret",
+		"auth_settings.0.github.0.client_secret",
+		"auth_settings.0.google.0.client_secret",
+		"auth_settings.0.microsoft.0.client_secret",
+		"auth_settings.0.twitter.0.consumer_secret",
+		"site_config.0.application_insights_connection_string",
+		"site_config.0.application_insights_key",
+	},
+	"azurerm_function_app_slot": {
+		"storage_account_access_key",
+	},
+	"azurerm_hdinsight_hadoop_cluster": {
+		"extension.0.primary_key",
+		"gateway.0.password",
+		"metastores.0.ambari.0.password",
+		"metastores.0.hive.0.password",
+		"metastores.0.oozie.0.password",
+		"monitor.0.primary_key",
+		"roles.0.head_node.0.password",
+		"roles.0.worker_node.0.password",
+		"roles.0.zookeeper_node.0.password",
+		"security_profile.0.domain_user_password",
+		"storage_account.0.storage_account_key",
+	},
+	"azurerm_hdinsight_hbase_cluster": {
+		"extension.0.primary_key",
+		"gateway.0.password",
+		"metastores.0.ambari.0.password",
+		"metastores.0.hive.0.password",
+		"metastores.0.oozie.0.password",
+		"monitor.0.primary_key",
+		"roles.0.head_node.0.password",
+		"roles.0.worker_node.0.password",
+		"roles.0.zookeeper_node.0.password",
+		"security_profile.0.domain_user_password",
+		"storage_account.0.storage_account_key",
+	},
+	"azurerm_hdinsight_interactive_query_cluster": {
+		"extension.0.primary_key",
+		"gateway.0.password",
+		"metastores.0.ambari.0.password",
+		"metastores.0.hive.0.password",
+		"metastores.0.oozie.0.password",
+		"monitor.0.primary_key",
+		"roles.0.head_node.0.password",
+		"roles.0.worker_node.0.password",
+		"roles.0.zookeeper_node.0.password",
+		"security_profile.0.domain_user_password",
+		"storage_account.0.storage_account_key",
+	},
+	"azurerm_hdinsight_kafka_cluster": {
+		"extension.0.primary_key",
+		"gateway.0.password",
+		"metastores.0.ambari.0.password",
+		"metastores.0.hive.0.password",
+		"metastores.0.oozie.0.password",
+		"monitor.0.primary_key",
+		"roles.0.head_node.0.password",
+		"roles.0.kafka_management_node.0.password",
+		"roles.0.worker_node.0.password",
+		"roles.0.zookeeper_node.0.password",
+		"security_profile.0.domain_user_password",
+		"storage_account.0.storage_account_key",
+	},
+	"azurerm_hdinsight_spark_cluster": {
+		"extension.0.primary_key",
+		"gateway.0.password",
+		"metastores.0.ambari.0.password",
+		"metastores.0.hive.0.password",
+		"metastores.0.oozie.0.password",
+		"monitor.0.primary_key",
+		"roles.0.head_node.0.password",
+		"roles.0.worker_node.0.password",
+		"roles.0.zookeeper_node.0.password",
+		"security_profile.0.domain_user_password",
+		"storage_account.0.storage_account_key",
+	},
+	"azurerm_hpc_cache": {
+		"directory_active_directory.0.password",
+	},
+	"azurerm_iothub": {
+		"file_upload.0.connection_string",
+	},
+	"azurerm_iothub_certificate": {
+		"certificate_content",
+	},
+	"azurerm_iothub_device_update_instance": {
+		"diagnostic_storage_account.0.connection_string",
+	},
+	"azurerm_iothub_dps": {
+		"linked_hub.0.connection_string",
+	},
+	"azurerm_iothub_dps_certificate": {
+		"certificate_content",
+	},
+	"azurerm_iothub_endpoint_cosmosdb_account": {
+		"primary_key",
+		"secondary_key",
+	},
+	"azurerm_iothub_endpoint_eventhub": {
+		"connection_string",
+	},
+	"azurerm_iothub_endpoint_servicebus_queue": {
+		"connection_string",
+	},
+	"azurerm_iothub_endpoint_servicebus_topic": {
+		"connection_string",
+	},
+	"azurerm_iothub_endpoint_storage_container": {
+		"connection_string",
+	},
+	"azurerm_iothub_file_upload": {
+		"connection_string",
+	},
+	"azurerm_key_vault_certificate": {
+		"certificate.0.contents",
+		"certificate.0.password",
+	},
+	"azurerm_key_vault_certificate_issuer": {
+		"password",
+	},
+	"azurerm_kubernetes_cluster": {
+		"http_proxy_config.0.trusted_ca",
+		"service_principal.0.client_secret",
+	},
+	"azurerm_kubernetes_flux_configuration": {
+		"blob_storage.0.account_key",
+		"blob_storage.0.sas_token",
+		"blob_storage.0.service_principal.0.client_certificate_base64",
+		"blob_storage.0.service_principal.0.client_certificate_password",
+		"blob_storage.0.service_principal.0.client_secret",
+		"bucket.0.secret_key_base64",
+		"git_repository.0.https_ca_cert_base64",
+		"git_repository.0.https_key_base64",
+		"git_repository.0.ssh_private_key_base64",
+	},
+	"azurerm_kusto_script": {
+		"sas_token",
+		"script_content",
+	},
+	"azurerm_linux_function_app": {
+		"auth_settings.0.active_directory.0.client_secret",
+		"auth_settings.0.facebook.0.app_secret",
+		"auth_settings.0.github.0.client_secret",
+		"auth_settings.0.google.0.client_secret",
+		"auth_settings.0.microsoft.0.client_secret",
+		"auth_settings.0.twitter.0.consumer_secret",
+		"backup.0.storage_account_url",
+		"site_config.0.application_insights_connection_string",
+		"site_config.0.application_insights_key",
+		"site_config.0.application_stack.0.docker.0.registry_password",
+		"site_config.0.application_stack.0.docker.0.registry_username",
+		"storage_account_access_key",
+	},
+	"azurerm_linux_function_app_slot": {
+		"auth_settings.0.active_directory.0.client_secret",
+		"auth_settings.0.facebook.0.app_secret",
+		"auth_settings.0.github.0.client_secret",
+		"auth_settings.0.google.0.client_secret",
+		"auth_settings.0.microsoft.0.client_secret",
+		"auth_settings.0.twitter.0.consumer_secret",
+		"backup.0.storage_account_url",
+		"site_config.0.application_insights_connection_string",
+		"site_config.0.application_insights_key",
+		"site_config.0.application_stack.0.docker.0.registry_password",
+		"site_config.0.application_stack.0.docker.0.registry_username",
+		"storage_account_access_key",
+	},
+	"azurerm_linux_virtual_machine": {
+		"custom_data",
+	},
+	"azurerm_linux_virtual_machine_scale_set": {
+		"admin_password",
+		"custom_data",
+	},
+	"azurerm_linux_web_app": {
+		"auth_settings.0.active_directory.0.client_secret",
+		"auth_settings.0.facebook.0.app_secret",
+		"auth_settings.0.github.0.client_secret",
+		"auth_settings.0.google.0.client_secret",
+		"auth_settings.0.microsoft.0.client_secret",
+		"auth_settings.0.twitter.0.consumer_secret",
+		"backup.0.storage_account_url",
+		"logs.0.http_logs.0.azure_blob_storage.0.sas_url",
+	},
+	"azurerm_linux_web_app_slot": {
+		"auth_settings.0.active_directory.0.client_secret",
+		"auth_settings.0.facebook.0.app_secret",
+		"auth_settings.0.github.0.client_secret",
+		"auth_settings.0.google.0.client_secret",
+		"auth_settings.0.microsoft.0.client_secret",
+		"auth_settings.0.twitter.0.consumer_secret",
+		"backup.0.storage_account_url",
+		"logs.0.http_logs.0.azure_blob_storage.0.sas_url",
+	},
+	"azurerm_log_analytics_storage_insights": {
+		"storage_account_key",
+	},
+	"azurerm_logic_app_standard": {
+		"storage_account_access_key",
+	},
+	"azurerm_machine_learning_compute_cluster": {
+		"ssh.0.admin_password",
+	},
+	"azurerm_machine_learning_datastore_blobstorage": {
+		"account_key",
+		"shared_access_signature",
+	},
+	"azurerm_machine_learning_datastore_datalake_gen2": {
+		"client_secret",
+	},
+	"azurerm_machine_learning_datastore_fileshare": {
+		"account_key",
+		"shared_access_signature",
+	},
+	"azurerm_mongo_cluster": {
+		"administrator_password",
+	},
+	"azurerm_mssql_database": {
+		"import.0.administrator_login_password",
+		"import.0.storage_key",
+	},
+	"azurerm_mssql_database_extended_auditing_policy": {
+		"storage_account_access_key",
+	},
+	"azurerm_mssql_managed_instance": {
+		"administrator_login_password",
+	},
+	"azurerm_mssql_managed_instance_security_alert_policy": {
+		"storage_account_access_key",
+	},
+	"azurerm_mssql_managed_instance_vulnerability_assessment": {
+		"storage_account_access_key",
+		"storage_container_sas_key",
+	},
+	"azurerm_mssql_server_extended_auditing_policy": {
+		"storage_account_access_key",
+		"storage_account_subscription_id",
+	},
+	"azurerm_mssql_server_microsoft_support_auditing_policy": {
+		"storage_account_access_key",
+		"storage_account_subscription_id",
+	},
+	"azurerm_mssql_server_security_alert_policy": {
+		"storage_account_access_key",
+	},
+	"azurerm_mssql_server_vulnerability_assessment": {
+		"storage_account_access_key",
+		"storage_container_sas_key",
+	},
+	"azurerm_mssql_virtual_machine": {
+		"auto_backup.0.encryption_password",
+		"key_vault_credential.0.key_vault_url",
+		"key_vault_credential.0.service_principal_name",
+		"key_vault_credential.0.service_principal_secret",
+		"sql_connectivity_update_password",
+		"sql_connectivity_update_username",
+		"wsfc_domain_credential.0.cluster_bootstrap_account_password",
+		"wsfc_domain_credential.0.cluster_operator_account_password",
+		"wsfc_domain_credential.0.sql_service_account_password",
+	},
+	"azurerm_mssql_virtual_machine_group": {
+		"wsfc_domain_profile.0.storage_account_primary_key",
+	},
+	"azurerm_netapp_account": {
+		"active_directory.0.password",
+		"active_directory.0.server_root_ca_certificate",
+	},
+	"azurerm_netapp_volume_bucket_with_server": {
+		"server.0.certificate_pem",
+	},
+	"azurerm_new_relic_monitor": {
+		"ingestion_key",
+	},
+	"azurerm_nginx_api_key": {
+		"secret_text",
+	},
+	"azurerm_notification_hub": {
+		"apns_credential.0.token",
+		"browser_credential.0.vapid_private_key",
+		"gcm_credential.0.api_key",
+	},
+	"azurerm_oracle_autonomous_database": {
+		"admin_password",
+	},
+	"azurerm_oracle_autonomous_database_clone_from_backup": {
+		"admin_password",
+	},
+	"azurerm_oracle_autonomous_database_clone_from_database": {
+		"admin_password",
+	},
+	"azurerm_orchestrated_virtual_machine_scale_set": {
+		"os_profile.0.custom_data",
+		"os_profile.0.linux_configuration.0.admin_password",
+		"os_profile.0.windows_configuration.0.additional_unattend_content.0.content",
+		"os_profile.0.windows_configuration.0.admin_password",
+		"user_data_base64",
+	},
+	"azurerm_postgresql_server": {
+		"threat_detection_policy.0.storage_account_access_key",
+	},
+	"azurerm_qumulo_file_system": {
+		"admin_password",
+	},
+	"azurerm_redhat_openshift_cluster": {
+		"cluster_profile.0.pull_secret",
+		"service_principal.0.client_secret",
+	},
+	"azurerm_resource_deployment_script_azure_cli": {
+		"storage_account.0.key",
+	},
+	"azurerm_resource_deployment_script_azure_power_shell": {
+		"storage_account.0.key",
+	},
+	"azurerm_security_center_automation": {
+		"action.0.connection_string",
+		"action.0.trigger_url",
+	},
+	"azurerm_sentinel_data_connector_threat_intelligence_taxii": {
+		"password",
+		"user_name",
+	},
+	"azurerm_service_fabric_managed_cluster": {
+		"password",
+	},
+	"azurerm_source_control_token": {
+		"token",
+		"token_secret",
+	},
+	"azurerm_spring_cloud_app_dynamics_application_performance_monitoring": {
+		"agent_account_access_key",
+		"agent_account_name",
+	},
+	"azurerm_spring_cloud_app_mysql_association": {
+		"password",
+	},
+	"azurerm_spring_cloud_configuration_service": {
+		"repository.0.password",
+		"repository.0.private_key",
+	},
+	"azurerm_spring_cloud_connection": {
+		"authentication.0.certificate",
+		"authentication.0.secret",
+	},
+	"azurerm_spring_cloud_customized_accelerator": {
+		"git_repository.0.basic_auth.0.password",
+		"git_repository.0.ssh_auth.0.host_key",
+		"git_repository.0.ssh_auth.0.private_key",
+	},
+	"azurerm_spring_cloud_dynatrace_application_performance_monitoring": {
+		"api_token",
+		"tenant",
+		"tenant_token",
+	},
+	"azurerm_spring_cloud_new_relic_application_performance_monitoring": {
+		"license_key",
+	},
+	"azurerm_spring_cloud_service": {
+		"config_server_git_setting.0.http_basic_auth.0.password",
+		"config_server_git_setting.0.repository.0.http_basic_auth.0.password",
+		"config_server_git_setting.0.repository.0.ssh_auth.0.host_key",
+		"config_server_git_setting.0.repository.0.ssh_auth.0.private_key",
+		"config_server_git_setting.0.ssh_auth.0.host_key",
+		"config_server_git_setting.0.ssh_auth.0.private_key",
+		"container_registry.0.password",
+	},
+	"azurerm_stack_hci_extension": {
+		"protected_settings",
+	},
+	"azurerm_static_web_app": {
+		"basic_auth.0.password",
+		"repository_token",
+	},
+	"azurerm_stream_analytics_job": {
+		"job_storage_account.0.account_key",
+	},
+	"azurerm_stream_analytics_job_storage_account": {
+		"storage_account_key",
+	},
+	"azurerm_stream_analytics_output_blob": {
+		"storage_account_key",
+	},
+	"azurerm_stream_analytics_output_cosmosdb": {
+		"cosmosdb_account_key",
+	},
+	"azurerm_stream_analytics_output_eventhub": {
+		"shared_access_policy_key",
+	},
+	"azurerm_stream_analytics_output_function": {
+		"api_key",
+	},
+	"azurerm_stream_analytics_output_mssql": {
+		"password",
+	},
+	"azurerm_stream_analytics_output_servicebus_queue": {
+		"shared_access_policy_key",
+	},
+	"azurerm_stream_analytics_output_servicebus_topic": {
+		"shared_access_policy_key",
+	},
+	"azurerm_stream_analytics_output_synapse": {
+		"password",
+	},
+	"azurerm_stream_analytics_output_table": {
+		"storage_account_key",
+	},
+	"azurerm_stream_analytics_reference_input_blob": {
+		"storage_account_key",
+	},
+	"azurerm_stream_analytics_reference_input_mssql": {
+		"password",
+	},
+	"azurerm_stream_analytics_stream_input_blob": {
+		"storage_account_key",
+	},
+	"azurerm_stream_analytics_stream_input_eventhub": {
+		"shared_access_policy_key",
+	},
+	"azurerm_stream_analytics_stream_input_eventhub_v2": {
+		"shared_access_policy_key",
+	},
+	"azurerm_stream_analytics_stream_input_iothub": {
+		"shared_access_policy_key",
+	},
+	"azurerm_synapse_sql_pool_extended_auditing_policy": {
+		"storage_account_access_key",
+	},
+	"azurerm_synapse_sql_pool_security_alert_policy": {
+		"storage_account_access_key",
+	},
+	"azurerm_synapse_sql_pool_vulnerability_assessment": {
+		"storage_account_access_key",
+		"storage_container_sas_key",
+	},
+	"azurerm_synapse_workspace": {
+		"sql_administrator_login_password",
+	},
+	"azurerm_synapse_workspace_extended_auditing_policy": {
+		"storage_account_access_key",
+	},
+	"azurerm_synapse_workspace_security_alert_policy": {
+		"storage_account_access_key",
+	},
+	"azurerm_synapse_workspace_vulnerability_assessment": {
+		"storage_account_access_key",
+		"storage_container_sas_key",
+	},
+	"azurerm_system_center_virtual_machine_manager_server": {
+		"password",
+	},
+	"azurerm_system_center_virtual_machine_manager_virtual_machine_instance": {
+		"operating_system.0.admin_password",
+	},
+	"azurerm_system_center_virtual_machine_manager_virtual_machine_instance_guest_agent": {
+		"password",
+	},
+	"azurerm_virtual_machine_extension": {
+		"protected_settings",
+	},
+	"azurerm_virtual_machine_run_command": {
+		"error_blob_managed_identity.0.client_id",
+		"error_blob_managed_identity.0.object_id",
+		"output_blob_managed_identity.0.client_id",
+		"output_blob_managed_identity.0.object_id",
+		"protected_parameter.0.name",
+		"protected_parameter.0.value",
+		"run_as_password",
+		"source.0.script_uri_managed_identity.0.client_id",
+		"source.0.script_uri_managed_identity.0.object_id",
+	},
+	"azurerm_virtual_machine_scale_set": {
+		"os_profile.0.admin_password",
+	},
+	"azurerm_virtual_machine_scale_set_extension": {
+		"protected_settings",
+	},
+	"azurerm_virtual_network_gateway": {
+		"vpn_client_configuration.0.radius_server.0.secret",
+		"vpn_client_configuration.0.radius_server_secret",
+	},
+	"azurerm_virtual_network_gateway_connection": {
+		"authorization_key",
+		"shared_key",
+	},
+	"azurerm_vmware_private_cloud": {
+		"nsxt_password",
+		"vcenter_password",
+	},
+	"azurerm_vpn_server_configuration": {
+		"radius.0.server.0.secret",
+	},
+	"azurerm_windows_function_app": {
+		"auth_settings.0.active_directory.0.client_secret",
+		"auth_settings.0.facebook.0.app_secret",
+		"auth_settings.0.github.0.client_secret",
+		"auth_settings.0.google.0.client_secret",
+		"auth_settings.0.microsoft.0.client_secret",
+		"auth_settings.0.twitter.0.consumer_secret",
+		"backup.0.storage_account_url",
+		"site_config.0.application_insights_connection_string",
+		"site_config.0.application_insights_key",
+		"storage_account_access_key",
+	},
+	"azurerm_windows_function_app_slot": {
+		"auth_settings.0.active_directory.0.client_secret",
+		"auth_settings.0.facebook.0.app_secret",
+		"auth_settings.0.github.0.client_secret",
+		"auth_settings.0.google.0.client_secret",
+		"auth_settings.0.microsoft.0.client_secret",
+		"auth_settings.0.twitter.0.consumer_secret",
+		"backup.0.storage_account_url",
+		"site_config.0.application_insights_connection_string",
+		"site_config.0.application_insights_key",
+		"storage_account_access_key",
+	},
+	"azurerm_windows_virtual_machine": {
+		"additional_unattend_content.0.content",
+		"custom_data",
+	},
+	"azurerm_windows_virtual_machine_scale_set": {
+		"additional_unattend_content.0.content",
+		"admin_password",
+		"custom_data",
+	},
+	"azurerm_windows_web_app": {
+		"auth_settings.0.active_directory.0.client_secret",
+		"auth_settings.0.facebook.0.app_secret",
+		"auth_settings.0.github.0.client_secret",
+		"auth_settings.0.google.0.client_secret",
+		"auth_settings.0.microsoft.0.client_secret",
+		"auth_settings.0.twitter.0.consumer_secret",
+		"backup.0.storage_account_url",
+		"logs.0.http_logs.0.azure_blob_storage.0.sas_url",
+	},
+	"azurerm_windows_web_app_slot": {
+		"auth_settings.0.active_directory.0.client_secret",
+		"auth_settings.0.facebook.0.app_secret",
+		"auth_settings.0.github.0.client_secret",
+		"auth_settings.0.google.0.client_secret",
+		"auth_settings.0.microsoft.0.client_secret",
+		"auth_settings.0.twitter.0.consumer_secret",
+		"backup.0.storage_account_url",
+		"logs.0.http_logs.0.azure_blob_storage.0.sas_url",
+	},
+	"azurerm_workloads_sap_single_node_virtual_instance": {
+		"single_server_configuration.0.virtual_machine_configuration.0.os_profile.0.ssh_private_key",
+	},
+	"azurerm_workloads_sap_three_tier_virtual_instance": {
+		"three_tier_configuration.0.application_server_configuration.0.virtual_machine_configuration.0.os_profile.0.ssh_private_key",
+		"three_tier_configuration.0.central_server_configuration.0.virtual_machine_configuration.0.os_profile.0.ssh_private_key",
+		"three_tier_configuration.0.database_server_configuration.0.virtual_machine_configuration.0.os_profile.0.ssh_private_key",
+	},
+}